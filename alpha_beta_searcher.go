@@ -2,17 +2,21 @@ package chessminimax
 
 import (
 	"errors"
+	"math"
+	"sort"
 
 	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
 
 // ...
 var (
-	ErrCheckmate = errors.New("checkmate")
-	ErrDraw      = errors.New("draw")
+	ErrCheckmate     = errors.New("checkmate")
+	ErrDraw          = errors.New("draw")
+	ErrNoAllowedMove = errors.New("no allowed legal move")
 )
 
 // MoveGenerator ...
@@ -27,6 +31,8 @@ type MoveGenerator interface {
 type AlphaBetaSearcher struct {
 	*SearcherSetter
 	*TerminatorSetter
+	*RootGeneratorSetter
+	*ObserverSetter
 
 	generator MoveGenerator
 	evaluator evaluators.BoardEvaluator
@@ -41,8 +47,10 @@ func NewAlphaBetaSearcher(
 	// instance must be created in a heap so that it's possible to add
 	// a reference to itself inside
 	searcher := AlphaBetaSearcher{
-		SearcherSetter:   new(SearcherSetter),
-		TerminatorSetter: new(TerminatorSetter),
+		SearcherSetter:      new(SearcherSetter),
+		TerminatorSetter:    new(TerminatorSetter),
+		RootGeneratorSetter: new(RootGeneratorSetter),
+		ObserverSetter:      new(ObserverSetter),
 
 		generator: generator,
 		evaluator: evaluator,
@@ -55,6 +63,21 @@ func NewAlphaBetaSearcher(
 	return searcher
 }
 
+// SetObserver ...
+//
+// It also sets the observer to bound CachedSearcher, if any.
+func (searcher AlphaBetaSearcher) SetObserver(
+	observer observers.SearchObserver,
+) {
+	searcher.ObserverSetter.SetObserver(observer)
+
+	// the cached searcher forwards the observer to this searcher,
+	// so it shouldn't be forwarded back
+	if cachedSearcher, ok := searcher.searcher.(CachedSearcher); ok {
+		cachedSearcher.ObserverSetter.SetObserver(observer)
+	}
+}
+
 // SearchMove ...
 func (searcher AlphaBetaSearcher) SearchMove(
 	storage models.PieceStorage,
@@ -64,20 +87,34 @@ func (searcher AlphaBetaSearcher) SearchMove(
 ) (moves.ScoredMove, error) {
 	// check for a check should be first, including before a termination check,
 	// because a terminated evaluation doesn't make sense for a check position
-	moveGroup, err := searcher.generator.MovesForColor(storage, color)
+	generator := searcher.generatorForDeep(deep)
+	moveGroup, err := generator.MovesForColor(storage, color)
 	if err != nil {
 		return moves.ScoredMove{}, err
 	}
 
+	terminators.CountNode(searcher.terminator, deep)
 	if ok := searcher.terminator.IsSearchTerminated(deep); ok {
 		score := searcher.evaluator.EvaluateBoard(storage, color)
 		return moves.ScoredMove{Score: score}, nil
 	}
 
+	// it's impossible to find a shorter checkmate on a root
+	if deep != 0 {
+		if score, ok := pruneMateDistance(bounds, deep); ok {
+			return moves.ScoredMove{Score: score}, nil
+		}
+	}
+
 	var hasCheck bool
 	bestMove := moves.NewScoredMove()
 	moveQuality := evaluateQuality(searcher, deep)
-	for _, move := range moveGroup {
+	observer := searcher.currentObserver()
+	for index, move := range moveGroup {
+		if deep == 0 {
+			observer.RootMoveStarted(move, index+1)
+		}
+
 		nextStorage := storage.ApplyMove(move)
 		nextColor := color.Negative()
 		nextDeep := deep + 1
@@ -91,31 +128,126 @@ func (searcher AlphaBetaSearcher) SearchMove(
 
 		scoredMove, ok := bounds.Update(scoredMove, move, moveQuality)
 		if !ok {
+			observer.Cutoff(deep, move)
 			return scoredMove, nil
 		}
 
+		previousBestMove := bestMove
 		bestMove.Update(scoredMove, move, moveQuality)
+		if deep == 0 && bestMove != previousBestMove {
+			observer.BestRootMoveChanged(bestMove)
+		}
 	}
 	// has a legal move
 	if bestMove.IsUpdated() {
 		return bestMove, nil
 	}
 
-	// hasn't a legal move
-	if hasCheck {
-		// check, if a king is under an attack
+	return searcher.searchWithoutMoves(storage, color, deep, hasCheck)
+}
+
+// ScoreMoves ...
+//
+// It scores all legal moves on a root of a search with a full window,
+// so their scores are exact, unlike ones of SearchMove(), which prunes moves
+// worse than a best one. The moves are sorted by their scores
+// in a descending order; moves with equal scores keep an order
+// of the generator.
+//
+// If there isn't a legal move, it returns ErrCheckmate or ErrDraw.
+// If there isn't a legal move only among ones of a root generator,
+// it returns ErrNoAllowedMove.
+func (searcher AlphaBetaSearcher) ScoreMoves(
+	storage models.PieceStorage,
+	color models.Color,
+) ([]moves.ScoredMove, error) {
+	generator := searcher.generatorForDeep(0)
+	moveGroup, err := generator.MovesForColor(storage, color)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasCheck bool
+	var scoredMoves []moves.ScoredMove
+	moveQuality := evaluateQuality(searcher, 0)
+	observer := searcher.currentObserver()
+	for index, move := range moveGroup {
+		observer.RootMoveStarted(move, index+1)
+
+		nextStorage := storage.ApplyMove(move)
 		nextColor := color.Negative()
-		_, err := searcher.generator.MovesForColor(storage, nextColor)
-		if err != nil {
-			score := evaluateCheckmate(deep)
-			return moves.ScoredMove{Score: score}, ErrCheckmate
+		nextBounds := moves.NewBounds().Next()
+		scoredMove, err :=
+			searcher.searcher.SearchMove(nextStorage, nextColor, 1, nextBounds)
+		if err == models.ErrKingCapture {
+			hasCheck = true
+			continue
 		}
+
+		rootMove := moves.NewScoredMove()
+		rootMove.Update(scoredMove, move, moveQuality)
+		scoredMoves = append(scoredMoves, rootMove)
+	}
+	// has a legal move
+	if len(scoredMoves) != 0 {
+		sort.SliceStable(scoredMoves, func(i int, j int) bool {
+			return scoredMoves[i].Score > scoredMoves[j].Score
+		})
+
+		return scoredMoves, nil
+	}
+
+	_, err = searcher.searchWithoutMoves(storage, color, 0, hasCheck)
+	return nil, err
+}
+
+// it evaluates a position without legal moves
+func (searcher AlphaBetaSearcher) searchWithoutMoves(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	hasCheck bool,
+) (moves.ScoredMove, error) {
+	// moves of a root generator may be only a subset of legal ones
+	if deep == 0 && searcher.rootGenerator != nil {
+		legalMoves, err := moves.LegalMoves(searcher.generator, storage, color)
+		if err == nil && len(legalMoves) != 0 {
+			return moves.ScoredMove{}, ErrNoAllowedMove
+		}
+
+		// allowed moves may be not tried at all, so a check can't be inferred
+		// from them
+		hasCheck = true
+	}
+
+	if hasCheck && isKingUnderAttack(searcher.generator, storage, color) {
+		score := evaluateCheckmate(deep)
+		return moves.ScoredMove{Score: score}, ErrCheckmate
 	}
 
 	// score of a draw is a null
 	return moves.ScoredMove{}, ErrDraw
 }
 
+func (searcher AlphaBetaSearcher) generatorForDeep(deep int) MoveGenerator {
+	if deep == 0 && searcher.rootGenerator != nil {
+		return searcher.rootGenerator
+	}
+
+	return searcher.generator
+}
+
+// it checks, if a king of a current side is under an attack
+func isKingUnderAttack(
+	generator MoveGenerator,
+	storage models.PieceStorage,
+	color models.Color,
+) bool {
+	nextColor := color.Negative()
+	_, err := generator.MovesForColor(storage, nextColor)
+	return err != nil
+}
+
 func evaluateQuality(searcher MoveSearcher, deep int) float64 {
 	return 1 - searcher.SearchProgress(deep)
 }
@@ -123,6 +255,22 @@ func evaluateQuality(searcher MoveSearcher, deep int) float64 {
 // it evaluates a score of a checkmate for a current side, so its result
 // should be negative
 func evaluateCheckmate(deep int) float64 {
-	score := 1e6 + float64(deep)
-	return -score
+	return moves.NewCheckmateScore(deep)
+}
+
+// it checks, if bounds can't be improved in a current node even by a fastest
+// checkmate, because a shorter one was already found
+func pruneMateDistance(
+	bounds moves.Bounds,
+	deep int,
+) (score float64, ok bool) {
+	// a worst case is a checkmate of a current side right now,
+	// a best one is a checkmate of an opponent on a next ply
+	alpha := math.Max(bounds.Alpha, evaluateCheckmate(deep))
+	beta := math.Min(bounds.Beta, -evaluateCheckmate(deep+1))
+	if alpha < beta {
+		return 0, false
+	}
+
+	return alpha, true
 }
@@ -6,8 +6,12 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
 )
 
 func TestAlphaBetaSearcher(test *testing.T) {
@@ -172,3 +176,99 @@ func TestAlphaBetaSearcher(test *testing.T) {
 		}
 	}
 }
+
+func TestAlphaBetaSearcher_withRootGenerator(test *testing.T) {
+	type args struct {
+		boardInFEN   string
+		color        models.Color
+		allowedMoves []models.Move
+	}
+	type data struct {
+		args     args
+		wantMove moves.ScoredMove
+		wantErr  error
+	}
+
+	kingMove := models.Move{
+		Start:  models.Position{File: 7, Rank: 7},
+		Finish: models.Position{File: 6, Rank: 7},
+	}
+	for _, data := range []data{
+		// an allowed legal move
+		{
+			args: args{
+				boardInFEN:   "7K/8/7q/8/8/8/7Q/k7",
+				color:        models.White,
+				allowedMoves: []models.Move{kingMove},
+			},
+			wantMove: moves.ScoredMove{Move: kingMove, Score: 0, Quality: 1},
+			wantErr:  nil,
+		},
+		// no allowed moves
+		{
+			args: args{
+				boardInFEN:   "7K/8/7q/8/8/8/7Q/k7",
+				color:        models.White,
+				allowedMoves: nil,
+			},
+			wantMove: moves.ScoredMove{},
+			wantErr:  ErrNoAllowedMove,
+		},
+		// no allowed legal moves
+		{
+			args: args{
+				boardInFEN: "7K/8/7q/8/8/8/7Q/k7",
+				color:      models.White,
+				allowedMoves: []models.Move{
+					{
+						Start:  models.Position{File: 7, Rank: 7},
+						Finish: models.Position{File: 7, Rank: 6},
+					},
+				},
+			},
+			wantMove: moves.ScoredMove{},
+			wantErr:  ErrNoAllowedMove,
+		},
+		// checkmate
+		{
+			args: args{
+				boardInFEN:   "6BK/8/8/8/8/pp6/k6R/7R",
+				color:        models.Black,
+				allowedMoves: nil,
+			},
+			wantMove: moves.ScoredMove{Score: evaluateCheckmate(0)},
+			wantErr:  ErrCheckmate,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var generator models.MoveGenerator
+		var evaluator evaluators.MaterialEvaluator
+		terminator := terminators.NewDeepTerminator(1)
+		searcher := NewAlphaBetaSearcher(generator, terminator, evaluator)
+		searcher.SetRootGenerator(
+			NewFilteredMoveGenerator(generator, data.args.allowedMoves),
+		)
+
+		gotMove, gotErr := searcher.SearchMove(
+			storage,
+			data.args.color,
+			0, // initial deep
+			moves.NewBounds(),
+		)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotErr, data.wantErr) {
+			test.Fail()
+		}
+	}
+}
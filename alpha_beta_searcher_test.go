@@ -1,6 +1,7 @@
 package chessminimax
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -92,6 +93,9 @@ func TestNewAlphaBetaSearcher(test *testing.T) {
 	if !reflect.DeepEqual(searcher.evaluator, evaluator) {
 		test.Fail()
 	}
+	if searcher.rootGenerator != nil {
+		test.Fail()
+	}
 
 	// check a reference to itself
 	if !reflect.DeepEqual(searcher.searcher, searcher) {
@@ -101,10 +105,11 @@ func TestNewAlphaBetaSearcher(test *testing.T) {
 
 func TestAlphaBetaSearcherSearchMove(test *testing.T) {
 	type fields struct {
-		generator  MoveGenerator
-		terminator terminators.SearchTerminator
-		evaluator  evaluators.BoardEvaluator
-		searcher   MoveSearcher
+		generator     MoveGenerator
+		rootGenerator MoveGenerator
+		terminator    terminators.SearchTerminator
+		evaluator     evaluators.BoardEvaluator
+		searcher      MoveSearcher
 	}
 	type args struct {
 		storage models.PieceStorage
@@ -1577,6 +1582,211 @@ func TestAlphaBetaSearcherSearchMove(test *testing.T) {
 			wantMove: moves.ScoredMove{},
 			wantErr:  ErrDraw,
 		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						panic("not implemented")
+					},
+				},
+				rootGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+
+						moves := []models.Move{
+							{
+								Start: models.Position{
+									File: 5,
+									Rank: 6,
+								},
+								Finish: models.Position{
+									File: 7,
+									Rank: 8,
+								},
+							},
+						}
+						return moves, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						if deep != 0 {
+							test.Fail()
+						}
+
+						return false
+					},
+					searchProgress: func(deep int) float64 {
+						if deep != 0 {
+							test.Fail()
+						}
+
+						return 0.75
+					},
+				},
+				searcher: MockMoveSearcher{
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						expectedStorage := MockPieceStorage{
+							appliedMove: models.Move{
+								Start: models.Position{
+									File: 5,
+									Rank: 6,
+								},
+								Finish: models.Position{
+									File: 7,
+									Rank: 8,
+								},
+							},
+						}
+						if !reflect.DeepEqual(storage, expectedStorage) {
+							test.Fail()
+						}
+						if color != models.Black {
+							test.Fail()
+						}
+						if deep != 1 {
+							test.Fail()
+						}
+						if !reflect.DeepEqual(bounds, moves.Bounds{Alpha: -3e6, Beta: 2e6}) {
+							test.Fail()
+						}
+
+						return moves.ScoredMove{Score: -4.2}, nil
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{
+					applyMove: func(move models.Move) models.PieceStorage {
+						return MockPieceStorage{
+							appliedMove: move,
+						}
+					},
+				},
+				color:  models.White,
+				deep:   0,
+				bounds: moves.Bounds{Alpha: -2e6, Beta: 3e6},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start: models.Position{
+						File: 5,
+						Rank: 6,
+					},
+					Finish: models.Position{
+						File: 7,
+						Rank: 8,
+					},
+				},
+				Score:   4.2,
+				Quality: 0.25,
+			},
+			wantErr: nil,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+
+						return nil, models.ErrKingCapture
+					},
+				},
+				rootGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						panic("not implemented")
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+				bounds:  moves.Bounds{Alpha: -2e6, Beta: 3e6},
+			},
+			wantMove: moves.ScoredMove{},
+			wantErr:  models.ErrKingCapture,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+
+						moves := []models.Move{
+							{
+								Start: models.Position{
+									File: 1,
+									Rank: 2,
+								},
+								Finish: models.Position{
+									File: 3,
+									Rank: 4,
+								},
+							},
+						}
+						return moves, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						if deep != 2 {
+							test.Fail()
+						}
+
+						return false
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+				bounds: moves.Bounds{
+					Alpha: -evaluateCheckmate(1),
+					Beta:  3e6,
+				},
+			},
+			wantMove: moves.ScoredMove{
+				Score: -evaluateCheckmate(1),
+			},
+			wantErr: nil,
+		},
 	} {
 		searcher := AlphaBetaSearcher{
 			SearcherSetter: &SearcherSetter{
@@ -1585,6 +1795,9 @@ func TestAlphaBetaSearcherSearchMove(test *testing.T) {
 			TerminatorSetter: &TerminatorSetter{
 				terminator: data.fields.terminator,
 			},
+			RootGeneratorSetter: &RootGeneratorSetter{
+				rootGenerator: data.fields.rootGenerator,
+			},
 
 			generator: data.fields.generator,
 			evaluator: data.fields.evaluator,
@@ -1606,6 +1819,207 @@ func TestAlphaBetaSearcherSearchMove(test *testing.T) {
 	}
 }
 
+func TestAlphaBetaSearcherSetObserver(test *testing.T) {
+	observer := MockSearchObserver{events: new([]string)}
+	searcher := NewAlphaBetaSearcher(
+		MockMoveGenerator{},
+		MockSearchTerminator{},
+		MockBoardEvaluator{},
+	)
+	cachedSearcher := NewCachedSearcher(searcher, MockCache{})
+	searcher.SetObserver(observer)
+
+	if !reflect.DeepEqual(searcher.observer, observer) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(cachedSearcher.observer, observer) {
+		test.Fail()
+	}
+}
+
+func TestAlphaBetaSearcherSearchMove_withObserver(test *testing.T) {
+	type data struct {
+		bounds     moves.Bounds
+		wantEvents []string
+	}
+
+	for _, data := range []data{
+		{
+			bounds: moves.NewBounds(),
+			wantEvents: []string{
+				"RootMoveStarted(0, 1)",
+				"BestRootMoveChanged(0, -1)",
+				"RootMoveStarted(1, 2)",
+				"BestRootMoveChanged(1, 3)",
+				"RootMoveStarted(2, 3)",
+			},
+		},
+		{
+			bounds: moves.Bounds{Alpha: math.Inf(-1), Beta: 2},
+			wantEvents: []string{
+				"RootMoveStarted(0, 1)",
+				"BestRootMoveChanged(0, -1)",
+				"RootMoveStarted(1, 2)",
+				"Cutoff(0, 1)",
+			},
+		},
+	} {
+		generator := MockMoveGenerator{
+			movesForColor: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) ([]models.Move, error) {
+				moveGroup := []models.Move{{Start: models.Position{File: 0}}}
+				if storage.(MockTreeStorage).path == "" {
+					moveGroup = append(
+						moveGroup,
+						models.Move{Start: models.Position{File: 1}},
+						models.Move{Start: models.Position{File: 2}},
+					)
+				}
+
+				return moveGroup, nil
+			},
+		}
+		evaluator := MockBoardEvaluator{
+			evaluateBoard: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) float64 {
+				scores := map[string]float64{"a": 1, "b": -3, "c": 2}
+				return scores[storage.(MockTreeStorage).path]
+			},
+		}
+
+		var events []string
+		searcher := NewAlphaBetaSearcher(
+			generator,
+			terminators.NewDeepTerminator(1),
+			evaluator,
+		)
+		searcher.SetObserver(MockSearchObserver{events: &events})
+		_, err := searcher.SearchMove(
+			MockTreeStorage{},
+			models.White,
+			0,
+			data.bounds,
+		)
+
+		if err != nil {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(events, data.wantEvents) {
+			test.Fail()
+		}
+	}
+}
+
+func TestAlphaBetaSearcherScoreMoves(test *testing.T) {
+	type data struct {
+		rootMoves  []models.Move
+		rootErr    error
+		wantMoves  []moves.ScoredMove
+		wantErr    error
+		wantEvents []string
+	}
+
+	for _, data := range []data{
+		{
+			rootMoves: []models.Move{
+				{Start: models.Position{File: 0}},
+				{Start: models.Position{File: 1}},
+				{Start: models.Position{File: 2}},
+				{Start: models.Position{File: 3}},
+			},
+			rootErr: nil,
+			wantMoves: []moves.ScoredMove{
+				{
+					Move:    models.Move{Start: models.Position{File: 1}},
+					Score:   3,
+					Quality: 1,
+				},
+				{
+					Move:    models.Move{Start: models.Position{File: 0}},
+					Score:   -1,
+					Quality: 1,
+				},
+				{
+					Move:    models.Move{Start: models.Position{File: 3}},
+					Score:   -1,
+					Quality: 1,
+				},
+				{
+					Move:    models.Move{Start: models.Position{File: 2}},
+					Score:   -2,
+					Quality: 1,
+				},
+			},
+			wantErr: nil,
+			wantEvents: []string{
+				"RootMoveStarted(0, 1)",
+				"RootMoveStarted(1, 2)",
+				"RootMoveStarted(2, 3)",
+				"RootMoveStarted(3, 4)",
+			},
+		},
+		{
+			rootMoves:  nil,
+			rootErr:    nil,
+			wantMoves:  nil,
+			wantErr:    ErrDraw,
+			wantEvents: nil,
+		},
+		{
+			rootMoves:  nil,
+			rootErr:    models.ErrKingCapture,
+			wantMoves:  nil,
+			wantErr:    models.ErrKingCapture,
+			wantEvents: nil,
+		},
+	} {
+		generator := MockMoveGenerator{
+			movesForColor: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) ([]models.Move, error) {
+				if storage.(MockTreeStorage).path == "" {
+					return data.rootMoves, data.rootErr
+				}
+
+				return []models.Move{{Start: models.Position{File: 0}}}, nil
+			},
+		}
+		evaluator := MockBoardEvaluator{
+			evaluateBoard: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) float64 {
+				scores := map[string]float64{"a": 1, "b": -3, "c": 2, "d": 1}
+				return scores[storage.(MockTreeStorage).path]
+			},
+		}
+
+		var events []string
+		searcher := NewAlphaBetaSearcher(
+			generator,
+			terminators.NewDeepTerminator(1),
+			evaluator,
+		)
+		searcher.SetObserver(MockSearchObserver{events: &events})
+		gotMoves, gotErr := searcher.ScoreMoves(MockTreeStorage{}, models.White)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(events, data.wantEvents) {
+			test.Fail()
+		}
+	}
+}
+
 func TestEvaluateCheckmate(test *testing.T) {
 	scoreOne := evaluateCheckmate(2)
 	scoreTwo := evaluateCheckmate(3)
@@ -1616,7 +2030,56 @@ func TestEvaluateCheckmate(test *testing.T) {
 	if scoreTwo >= 0 {
 		test.Fail()
 	}
-	if scoreTwo >= scoreOne {
+	// a later checkmate is better for a mated side
+	if scoreTwo <= scoreOne {
 		test.Fail()
 	}
 }
+
+func TestPruneMateDistance(test *testing.T) {
+	type args struct {
+		bounds moves.Bounds
+		deep   int
+	}
+	type data struct {
+		args      args
+		wantScore float64
+		wantOk    bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				bounds: moves.Bounds{Alpha: -2e6, Beta: 3e6},
+				deep:   2,
+			},
+			wantScore: 0,
+			wantOk:    false,
+		},
+		{
+			args: args{
+				bounds: moves.Bounds{Alpha: -evaluateCheckmate(1), Beta: 3e6},
+				deep:   2,
+			},
+			wantScore: -evaluateCheckmate(1),
+			wantOk:    true,
+		},
+		{
+			args: args{
+				bounds: moves.Bounds{Alpha: -2e6, Beta: evaluateCheckmate(1)},
+				deep:   2,
+			},
+			wantScore: evaluateCheckmate(2),
+			wantOk:    true,
+		},
+	} {
+		gotScore, gotOk := pruneMateDistance(data.args.bounds, data.args.deep)
+
+		if gotScore != data.wantScore {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
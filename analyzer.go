@@ -0,0 +1,154 @@
+package chessminimax
+
+import (
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+const (
+	maximalInt = int(^uint(0) >> 1)
+)
+
+// AnalysisUpdate ...
+type AnalysisUpdate struct {
+	Deep      int
+	Move      moves.ScoredMove
+	Error     error
+	Variation []models.Move
+	Nodes     int
+	Duration  time.Duration
+}
+
+// AnalysisHandler ...
+type AnalysisHandler func(update AnalysisUpdate)
+
+// Analyzer ...
+//
+// It runs an infinite analysis of a position and reports an update
+// after each completed iteration of iterative deepening via a handler.
+// The searcher should be IterativeSearcher or another one that completes
+// iterations of its terminator (see terminators.IterationTerminator),
+// e.g. ParallelSearcher with such workers, in which case only a main worker
+// reports.
+//
+// A principal variation is restored from the cache, if it's not nil,
+// so it should be shared with the searcher.
+type Analyzer struct {
+	searcher MoveSearcher
+	cache    caches.Cache
+	clock    terminators.Clock
+}
+
+type analysisTerminator struct {
+	terminators.SearchTerminator
+
+	storage   models.PieceStorage
+	color     models.Color
+	analyzer  Analyzer
+	counter   *terminators.NodeTerminator
+	startTime time.Time
+	handler   AnalysisHandler
+}
+
+// NewAnalyzer ...
+func NewAnalyzer(
+	searcher MoveSearcher,
+	cache caches.Cache,
+	clock terminators.Clock,
+) Analyzer {
+	return Analyzer{
+		searcher: searcher,
+		cache:    cache,
+		clock:    clock,
+	}
+}
+
+// Analyze ...
+//
+// It blocks until the terminator fires (e.g. ManualTerminator), so it's
+// natural to call it in a separate goroutine. It returns a result of a last
+// completed iteration.
+//
+// The handler is called from a goroutine of the searcher.
+func (analyzer Analyzer) Analyze(
+	storage models.PieceStorage,
+	color models.Color,
+	terminator terminators.SearchTerminator,
+	handler AnalysisHandler,
+) (moves.ScoredMove, error) {
+	// the group forwards visited nodes to all its terminators,
+	// so the counter counts them even if other terminators fire
+	counter := terminators.NewNodeTerminator(maximalInt)
+	analyzer.searcher.SetTerminator(analysisTerminator{
+		SearchTerminator: terminators.NewGroupTerminator(counter, terminator),
+
+		storage:   storage,
+		color:     color,
+		analyzer:  analyzer,
+		counter:   counter,
+		startTime: analyzer.clock(),
+		handler:   handler,
+	})
+
+	return analyzer.searcher.SearchMove(storage, color, 0, moves.NewBounds())
+}
+
+func (analyzer Analyzer) variation(
+	storage models.PieceStorage,
+	color models.Color,
+	move models.Move,
+	deep int,
+) []models.Move {
+	variation := []models.Move{move}
+	if analyzer.cache == nil {
+		return variation
+	}
+
+	// a root of a search may be missing in the cache, so a variation
+	// is restored from a position after a found move
+	nextStorage := storage.ApplyMove(move)
+	nextColor := color.Negative()
+	nextVariation :=
+		PrincipalVariation(analyzer.cache, nextStorage, nextColor, deep-1)
+	return append(variation, nextVariation...)
+}
+
+// CountNode ...
+func (terminator analysisTerminator) CountNode(deep int) {
+	terminators.CountNode(terminator.SearchTerminator, deep)
+}
+
+// IsIterationTerminated ...
+func (terminator analysisTerminator) IsIterationTerminated(deep int) bool {
+	return terminators.IsIterationTerminated(terminator.SearchTerminator, deep)
+}
+
+// CompleteIteration ...
+func (terminator analysisTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	terminators.CompleteIteration(terminator.SearchTerminator, deep, move)
+
+	update := AnalysisUpdate{
+		Deep:     deep,
+		Move:     move.Move,
+		Error:    move.Error,
+		Nodes:    terminator.counter.VisitedNodes(),
+		Duration: terminator.analyzer.clock().Sub(terminator.startTime),
+	}
+	if !move.Move.Move.IsZero() {
+		update.Variation = terminator.analyzer.variation(
+			terminator.storage,
+			terminator.color,
+			move.Move.Move,
+			deep,
+		)
+	}
+
+	terminator.handler(update)
+}
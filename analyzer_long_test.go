@@ -0,0 +1,90 @@
+// +build long
+
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestAnalyzer(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"kn6/n6q/PP6/8/8/8/7P/7K",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	innerSearcher := NewAlphaBetaSearcher(
+		generator,
+		nil, // terminator will be set automatically by the iterative searcher
+		evaluator,
+	)
+
+	cache := caches.NewStringHashingCache(1e6, uci.EncodePieceStorage)
+	// make and bind a cached searcher to inner one
+	NewCachedSearcher(innerSearcher, cache)
+	searcher := NewIterativeSearcher(
+		innerSearcher,
+		nil, // terminator will be set automatically by the analyzer
+	)
+
+	var deeps []int
+	var lastUpdate AnalysisUpdate
+	terminator := new(terminators.ManualTerminator)
+	analyzer := NewAnalyzer(searcher, cache, time.Now)
+	gotMove, gotErr := analyzer.Analyze(
+		storage,
+		models.White,
+		terminator,
+		func(update AnalysisUpdate) {
+			deeps = append(deeps, update.Deep)
+			lastUpdate = update
+
+			// the analysis is infinite, so it should be stopped manually
+			if update.Deep == 3 {
+				terminator.Terminate()
+			}
+		},
+	)
+
+	if !reflect.DeepEqual(deeps, []int{1, 2, 3}) {
+		test.Fail()
+	}
+
+	wantMove := models.Move{
+		Start:  models.Position{File: 1, Rank: 5},
+		Finish: models.Position{File: 1, Rank: 6},
+	}
+	if !reflect.DeepEqual(gotMove, lastUpdate.Move) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(lastUpdate.Move.Move, wantMove) {
+		test.Fail()
+	}
+	if lastUpdate.Move.Score != -4 {
+		test.Fail()
+	}
+	if len(lastUpdate.Variation) == 0 || len(lastUpdate.Variation) > 3 ||
+		!reflect.DeepEqual(lastUpdate.Variation[0], wantMove) {
+		test.Fail()
+	}
+	if lastUpdate.Nodes == 0 {
+		test.Fail()
+	}
+}
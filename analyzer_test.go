@@ -0,0 +1,146 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewAnalyzer(test *testing.T) {
+	var searcher MockMoveSearcher
+	var cache MockCache
+	clock := func() time.Time { return time.Time{} }
+	analyzer := NewAnalyzer(searcher, cache, clock)
+
+	if _, ok := analyzer.searcher.(MockMoveSearcher); !ok {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(analyzer.cache, cache) {
+		test.Fail()
+	}
+	if analyzer.clock == nil {
+		test.Fail()
+	}
+}
+
+func TestAnalyzerAnalyze(test *testing.T) {
+	var terminator terminators.SearchTerminator
+	searcher := MockMoveSearcher{
+		setTerminator: func(innerTerminator terminators.SearchTerminator) {
+			terminator = innerTerminator
+		},
+		searchMove: func(
+			storage models.PieceStorage,
+			color models.Color,
+			deep int,
+			bounds moves.Bounds,
+		) (moves.ScoredMove, error) {
+			var lastMove moves.ScoredMove
+			for deep := 1; deep <= 3; deep++ {
+				// two visited nodes per iteration
+				terminators.CountNode(terminator, deep)
+				terminators.CountNode(terminator, deep)
+				// checks of a termination shouldn't be counted
+				terminator.IsSearchTerminated(deep)
+
+				lastMove = moves.ScoredMove{
+					Move:  models.Move{Start: models.Position{File: deep}},
+					Score: float64(deep),
+				}
+				terminators.CompleteIteration(
+					terminator,
+					deep,
+					moves.FailedMove{Move: lastMove},
+				)
+			}
+
+			return lastMove, nil
+		},
+	}
+	cache := MockCache{
+		get: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) (move moves.FailedMove, ok bool) {
+			// a reply is always a same move
+			move = moves.FailedMove{
+				Move: moves.ScoredMove{
+					Move: models.Move{Start: models.Position{File: 7}},
+				},
+			}
+			return move, true
+		},
+	}
+	var clockCalls int
+	clock := func() time.Time {
+		clockCalls++
+		return time.Time{}.Add(time.Duration(clockCalls) * time.Second)
+	}
+
+	var updates []AnalysisUpdate
+	analyzer := NewAnalyzer(searcher, cache, clock)
+	gotMove, gotErr := analyzer.Analyze(
+		MockTreeStorage{},
+		models.White,
+		new(terminators.ManualTerminator),
+		func(update AnalysisUpdate) { updates = append(updates, update) },
+	)
+
+	wantUpdates := []AnalysisUpdate{
+		{
+			Deep: 1,
+			Move: moves.ScoredMove{
+				Move:  models.Move{Start: models.Position{File: 1}},
+				Score: 1,
+			},
+			Variation: []models.Move{{Start: models.Position{File: 1}}},
+			Nodes:     2,
+			Duration:  time.Second,
+		},
+		{
+			Deep: 2,
+			Move: moves.ScoredMove{
+				Move:  models.Move{Start: models.Position{File: 2}},
+				Score: 2,
+			},
+			Variation: []models.Move{
+				{Start: models.Position{File: 2}},
+				{Start: models.Position{File: 7}},
+			},
+			Nodes:    4,
+			Duration: 2 * time.Second,
+		},
+		{
+			Deep: 3,
+			Move: moves.ScoredMove{
+				Move:  models.Move{Start: models.Position{File: 3}},
+				Score: 3,
+			},
+			Variation: []models.Move{
+				{Start: models.Position{File: 3}},
+				{Start: models.Position{File: 7}},
+				{Start: models.Position{File: 7}},
+			},
+			Nodes:    6,
+			Duration: 3 * time.Second,
+		},
+	}
+	if !reflect.DeepEqual(updates, wantUpdates) {
+		test.Fail()
+	}
+
+	wantMove := moves.ScoredMove{
+		Move:  models.Move{Start: models.Position{File: 3}},
+		Score: 3,
+	}
+	if !reflect.DeepEqual(gotMove, wantMove) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
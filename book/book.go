@@ -0,0 +1,154 @@
+package book
+
+import (
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"sort"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// Book ...
+//
+// It's an opening book of the Polyglot format.
+type Book struct {
+	entries []Entry
+}
+
+// WeightedMove ...
+type WeightedMove struct {
+	Move   models.Move
+	Weight int
+}
+
+// MoveSelector ...
+//
+// It selects a single move from moves of a book for a position.
+// They are passed in an order of the book, so there is always at least one.
+type MoveSelector func(moves []WeightedMove) WeightedMove
+
+// NewBook ...
+//
+// It sorts the entries by their keys (stably, so moves of a position
+// keep their order).
+func NewBook(entries []Entry) Book {
+	entries = append([]Entry(nil), entries...)
+	sort.SliceStable(entries, func(i int, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return Book{entries: entries}
+}
+
+// ReadBook ...
+//
+// It reads entries of a book until an end of the reader. It returns
+// io.ErrUnexpectedEOF on an incomplete entry.
+func ReadBook(reader io.Reader) (Book, error) {
+	var entries []Entry
+	for {
+		var entry Entry
+		err := binary.Read(reader, binary.BigEndian, &entry)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Book{}, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return NewBook(entries), nil
+}
+
+// WriteBook ...
+//
+// It writes entries of the book sorted by their keys, as the Polyglot format
+// requires.
+func WriteBook(writer io.Writer, book Book) error {
+	return binary.Write(writer, binary.BigEndian, book.entries)
+}
+
+// Entries ...
+//
+// It returns a copy of all entries of the book sorted by their keys.
+func (book Book) Entries() []Entry {
+	return append([]Entry(nil), book.entries...)
+}
+
+// Moves ...
+//
+// It returns moves of the book for a position in an order of the book,
+// skipping ones with a promotion (see DecodeMove()). Moves aren't checked
+// for legality.
+func (book Book) Moves(
+	storage models.PieceStorage,
+	color models.Color,
+) []WeightedMove {
+	key := Key(storage, color)
+	index := sort.Search(len(book.entries), func(index int) bool {
+		return book.entries[index].Key >= key
+	})
+
+	var moves []WeightedMove
+	for ; index < len(book.entries) && book.entries[index].Key == key; index++ {
+		entry := book.entries[index]
+		move, ok := DecodeMove(entry.Move)
+		if !ok {
+			continue
+		}
+
+		moves = append(moves, WeightedMove{Move: move, Weight: int(entry.Weight)})
+	}
+
+	return moves
+}
+
+// SelectBestMove ...
+//
+// It selects a move with a maximal weight; from ones with equal weights,
+// it selects a first one.
+func SelectBestMove(moves []WeightedMove) WeightedMove {
+	bestMove := moves[0]
+	for _, move := range moves[1:] {
+		if move.Weight > bestMove.Weight {
+			bestMove = move
+		}
+	}
+
+	return bestMove
+}
+
+// NewWeightedRandomSelector ...
+//
+// It returns a selector that selects a move randomly with a probability
+// proportional to its weight. If all weights are zeros, moves are equally
+// probable.
+//
+// Its randomizer is seeded, so its results are deterministic for tests.
+// It isn't safe for concurrent use.
+func NewWeightedRandomSelector(seed int64) MoveSelector {
+	randomizer := rand.New(rand.NewSource(seed))
+	return func(moves []WeightedMove) WeightedMove {
+		var totalWeight int
+		for _, move := range moves {
+			totalWeight += move.Weight
+		}
+		if totalWeight == 0 {
+			return moves[randomizer.Intn(len(moves))]
+		}
+
+		threshold := randomizer.Intn(totalWeight)
+		for _, move := range moves {
+			threshold -= move.Weight
+			if threshold < 0 {
+				return move
+			}
+		}
+
+		// it's impossible, because the threshold is less than the total weight
+		return moves[len(moves)-1]
+	}
+}
@@ -0,0 +1,272 @@
+package book
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+const initialKey = 0x463b96181691fc9c
+
+// nolint: gochecknoglobals
+var (
+	// e2e4
+	kingPawnMove = models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	}
+	// d2d4
+	queenPawnMove = models.Move{
+		Start:  models.Position{File: 3, Rank: 1},
+		Finish: models.Position{File: 3, Rank: 3},
+	}
+)
+
+func makeTestEntries() []Entry {
+	return []Entry{
+		{Key: initialKey + 1, Move: 0x0001, Weight: 1},
+		{Key: initialKey, Move: EncodeMove(kingPawnMove), Weight: 10},
+		{Key: initialKey - 1, Move: 0x0002, Weight: 1},
+		// a7a8q
+		{Key: initialKey, Move: 0x4c38, Weight: 100},
+		{Key: initialKey, Move: EncodeMove(queenPawnMove), Weight: 5},
+	}
+}
+
+func TestNewBook(test *testing.T) {
+	entries := makeTestEntries()
+	book := NewBook(entries)
+
+	wantEntries := []Entry{
+		{Key: initialKey - 1, Move: 0x0002, Weight: 1},
+		{Key: initialKey, Move: EncodeMove(kingPawnMove), Weight: 10},
+		{Key: initialKey, Move: 0x4c38, Weight: 100},
+		{Key: initialKey, Move: EncodeMove(queenPawnMove), Weight: 5},
+		{Key: initialKey + 1, Move: 0x0001, Weight: 1},
+	}
+	if !reflect.DeepEqual(book.entries, wantEntries) {
+		test.Fail()
+	}
+	// the passed entries aren't changed
+	if !reflect.DeepEqual(entries, makeTestEntries()) {
+		test.Fail()
+	}
+}
+
+func TestReadBook(test *testing.T) {
+	type data struct {
+		data     []byte
+		wantBook Book
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			data:     nil,
+			wantBook: Book{},
+			wantErr:  nil,
+		},
+		{
+			data: []byte{
+				0x46, 0x3b, 0x96, 0x18, 0x16, 0x91, 0xfc, 0x9c,
+				0x03, 0x1c,
+				0x00, 0x0a,
+				0x00, 0x00, 0x00, 0x17,
+			},
+			wantBook: Book{
+				entries: []Entry{
+					{
+						Key:    initialKey,
+						Move:   EncodeMove(kingPawnMove),
+						Weight: 10,
+						Learn:  23,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			data:     []byte{0x46, 0x3b, 0x96, 0x18},
+			wantBook: Book{},
+			wantErr:  io.ErrUnexpectedEOF,
+		},
+	} {
+		gotBook, gotErr := ReadBook(bytes.NewReader(data.data))
+
+		if !reflect.DeepEqual(gotBook, data.wantBook) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestWriteBook(test *testing.T) {
+	file, err := ioutil.TempFile("", "book-*.bin")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.Remove(file.Name()) // nolint: errcheck
+	defer file.Close()           // nolint: errcheck
+
+	book := NewBook(makeTestEntries())
+	if err := WriteBook(file, book); err != nil {
+		test.Fatal(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		test.Fatal(err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		test.Fatal(err)
+	}
+	if info.Size() != 16*int64(len(book.entries)) {
+		test.Fail()
+	}
+
+	gotBook, gotErr := ReadBook(file)
+
+	if !reflect.DeepEqual(gotBook, book) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+func TestBookEntries(test *testing.T) {
+	book := NewBook(makeTestEntries())
+	entries := book.Entries()
+	entries[0].Weight = 23
+
+	if !reflect.DeepEqual(entries[1:], book.entries[1:]) {
+		test.Fail()
+	}
+	if book.entries[0].Weight == 23 {
+		test.Fail()
+	}
+}
+
+func TestBookMoves(test *testing.T) {
+	type data struct {
+		boardInFEN string
+		color      models.Color
+		wantMoves  []WeightedMove
+	}
+
+	for _, data := range []data{
+		{
+			boardInFEN: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			color:      models.White,
+			wantMoves: []WeightedMove{
+				{Move: kingPawnMove, Weight: 10},
+				{Move: queenPawnMove, Weight: 5},
+			},
+		},
+		{
+			boardInFEN: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			color:      models.Black,
+			wantMoves:  nil,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.boardInFEN, pieces.NewPiece, models.NewBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		book := NewBook(makeTestEntries())
+		gotMoves := book.Moves(storage, data.color)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSelectBestMove(test *testing.T) {
+	moves := []WeightedMove{
+		{Move: queenPawnMove, Weight: 5},
+		{Move: kingPawnMove, Weight: 10},
+		{Move: models.Move{}, Weight: 10},
+	}
+	gotMove := SelectBestMove(moves)
+
+	if !reflect.DeepEqual(gotMove, moves[1]) {
+		test.Fail()
+	}
+}
+
+func TestNewWeightedRandomSelector(test *testing.T) {
+	type data struct {
+		moves     []WeightedMove
+		wantMoves map[models.Move]bool
+	}
+
+	for _, data := range []data{
+		{
+			moves: []WeightedMove{
+				{Move: kingPawnMove, Weight: 10},
+				{Move: models.Move{}, Weight: 0},
+				{Move: queenPawnMove, Weight: 5},
+			},
+			wantMoves: map[models.Move]bool{
+				kingPawnMove:  true,
+				queenPawnMove: true,
+			},
+		},
+		{
+			moves: []WeightedMove{
+				{Move: kingPawnMove, Weight: 0},
+				{Move: queenPawnMove, Weight: 0},
+			},
+			wantMoves: map[models.Move]bool{
+				kingPawnMove:  true,
+				queenPawnMove: true,
+			},
+		},
+	} {
+		selector := NewWeightedRandomSelector(23)
+
+		gotMoves := make(map[models.Move]bool)
+		for i := 0; i < 100; i++ {
+			gotMoves[selector(data.moves).Move] = true
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+	}
+}
+
+func TestNewWeightedRandomSelector_withSeed(test *testing.T) {
+	moves := []WeightedMove{
+		{Move: kingPawnMove, Weight: 10},
+		{Move: queenPawnMove, Weight: 5},
+	}
+
+	var selections [][]models.Move
+	for i := 0; i < 2; i++ {
+		selector := NewWeightedRandomSelector(23)
+
+		var selection []models.Move
+		for j := 0; j < 20; j++ {
+			selection = append(selection, selector(moves).Move)
+		}
+
+		selections = append(selections, selection)
+	}
+
+	if !reflect.DeepEqual(selections[0], selections[1]) {
+		test.Fail()
+	}
+}
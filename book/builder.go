@@ -0,0 +1,161 @@
+package book
+
+import (
+	"math"
+	"sort"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// GameResult ...
+type GameResult int
+
+// ...
+const (
+	UnknownResult GameResult = iota
+	WhiteWin
+	BlackWin
+	Draw
+)
+
+// MoveStatistics ...
+//
+// It's counted from the point of view of a side, which makes a move.
+type MoveStatistics struct {
+	Wins   int
+	Draws  int
+	Losses int
+}
+
+// BuilderOptions ...
+type BuilderOptions struct {
+	// if it's zero, a ply isn't limited
+	MaximalPly int
+	// if it's zero, one is used
+	MinimalGames int
+}
+
+type moveKey struct {
+	key  uint64
+	move uint16
+}
+
+// Builder ...
+//
+// It builds a book from games by counting wins, draws and losses
+// for pairs of a position and a move.
+type Builder struct {
+	options    BuilderOptions
+	statistics map[moveKey]MoveStatistics
+}
+
+// NewBuilder ...
+func NewBuilder(options BuilderOptions) *Builder {
+	if options.MinimalGames == 0 {
+		options.MinimalGames = 1
+	}
+
+	return &Builder{
+		options:    options,
+		statistics: make(map[moveKey]MoveStatistics),
+	}
+}
+
+// AddGame ...
+//
+// It replays moves of a game from an initial position up to a maximal ply.
+// Games with an unknown result are skipped.
+func (builder *Builder) AddGame(
+	storage models.PieceStorage,
+	color models.Color,
+	moves []models.Move,
+	result GameResult,
+) {
+	if result == UnknownResult {
+		return
+	}
+
+	for ply, move := range moves {
+		maximalPly := builder.options.MaximalPly
+		if maximalPly != 0 && ply >= maximalPly {
+			break
+		}
+
+		key := moveKey{key: Key(storage, color), move: EncodeMove(move)}
+		statistics := builder.statistics[key]
+		switch {
+		case result == Draw:
+			statistics.Draws++
+		case (result == WhiteWin) == (color == models.White):
+			statistics.Wins++
+		default:
+			statistics.Losses++
+		}
+		builder.statistics[key] = statistics
+
+		storage = storage.ApplyMove(move)
+		color = color.Negative()
+	}
+}
+
+// Statistics ...
+func (builder *Builder) Statistics(
+	storage models.PieceStorage,
+	color models.Color,
+	move models.Move,
+) MoveStatistics {
+	key := moveKey{key: Key(storage, color), move: EncodeMove(move)}
+	return builder.statistics[key]
+}
+
+// Book ...
+//
+// A weight of a move is two points for a win and one for a draw. Weights are
+// scaled down proportionally, if they exceed a maximal one of the format.
+// Moves played in less than a minimal number of games or without points
+// are skipped. Moves of a position are sorted by their weights
+// in a descending order.
+func (builder *Builder) Book() Book {
+	var entries []Entry
+	var maximalWeight int
+	weights := make(map[moveKey]int)
+	for key, statistics := range builder.statistics {
+		games := statistics.Wins + statistics.Draws + statistics.Losses
+		weight := 2*statistics.Wins + statistics.Draws
+		if games < builder.options.MinimalGames || weight == 0 {
+			continue
+		}
+
+		weights[key] = weight
+		if weight > maximalWeight {
+			maximalWeight = weight
+		}
+	}
+
+	for key, weight := range weights {
+		if maximalWeight > math.MaxUint16 {
+			weight = weight * math.MaxUint16 / maximalWeight
+			if weight == 0 {
+				weight = 1
+			}
+		}
+
+		entries = append(entries, Entry{
+			Key:    key.key,
+			Move:   key.move,
+			Weight: uint16(weight),
+		})
+	}
+
+	// the book sorts entries by their keys stably,
+	// so it's enough to sort them by other fields only
+	sort.Slice(entries, func(i int, j int) bool {
+		if entries[i].Weight != entries[j].Weight {
+			return entries[i].Weight > entries[j].Weight
+		}
+
+		return entries[i].Move < entries[j].Move
+	})
+
+	return NewBook(entries)
+}
@@ -0,0 +1,165 @@
+package book
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// nolint: gochecknoglobals
+var (
+	// e7e5
+	kingPawnReply = models.Move{
+		Start:  models.Position{File: 4, Rank: 6},
+		Finish: models.Position{File: 4, Rank: 4},
+	}
+	// d7d5
+	queenPawnReply = models.Move{
+		Start:  models.Position{File: 3, Rank: 6},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+)
+
+func makeInitialStorage(test *testing.T) models.PieceStorage {
+	storage, err := uci.DecodePieceStorage(
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return storage
+}
+
+func TestNewBuilder(test *testing.T) {
+	builder := NewBuilder(BuilderOptions{MaximalPly: 10})
+
+	wantOptions := BuilderOptions{MaximalPly: 10, MinimalGames: 1}
+	if !reflect.DeepEqual(builder.options, wantOptions) {
+		test.Fail()
+	}
+	if builder.statistics == nil {
+		test.Fail()
+	}
+}
+
+func TestBuilderAddGame(test *testing.T) {
+	storage := makeInitialStorage(test)
+	builder := NewBuilder(BuilderOptions{MaximalPly: 1})
+	games := []struct {
+		moves  []models.Move
+		result GameResult
+	}{
+		{[]models.Move{kingPawnMove, kingPawnReply}, WhiteWin},
+		{[]models.Move{kingPawnMove, kingPawnReply}, BlackWin},
+		{[]models.Move{kingPawnMove}, Draw},
+		{[]models.Move{kingPawnMove}, UnknownResult},
+		{[]models.Move{queenPawnMove, queenPawnReply}, BlackWin},
+	}
+	for _, game := range games {
+		builder.AddGame(storage, models.White, game.moves, game.result)
+	}
+
+	got := builder.Statistics(storage, models.White, kingPawnMove)
+	if !reflect.DeepEqual(got, MoveStatistics{Wins: 1, Draws: 1, Losses: 1}) {
+		test.Fail()
+	}
+
+	got = builder.Statistics(storage, models.White, queenPawnMove)
+	if !reflect.DeepEqual(got, MoveStatistics{Losses: 1}) {
+		test.Fail()
+	}
+
+	// replies are beyond the maximal ply
+	nextStorage := storage.ApplyMove(kingPawnMove)
+	got = builder.Statistics(nextStorage, models.Black, kingPawnReply)
+	if !reflect.DeepEqual(got, MoveStatistics{}) {
+		test.Fail()
+	}
+}
+
+func TestBuilderAddGameWithReplies(test *testing.T) {
+	storage := makeInitialStorage(test)
+	builder := NewBuilder(BuilderOptions{})
+	builder.AddGame(
+		storage,
+		models.White,
+		[]models.Move{kingPawnMove, kingPawnReply},
+		BlackWin,
+	)
+
+	nextStorage := storage.ApplyMove(kingPawnMove)
+	got := builder.Statistics(nextStorage, models.Black, kingPawnReply)
+	if !reflect.DeepEqual(got, MoveStatistics{Wins: 1}) {
+		test.Fail()
+	}
+}
+
+func TestBuilderBook(test *testing.T) {
+	type data struct {
+		options     BuilderOptions
+		statistics  map[moveKey]MoveStatistics
+		wantEntries []Entry
+	}
+
+	kingPawnKey := moveKey{key: initialKey, move: EncodeMove(kingPawnMove)}
+	queenPawnKey := moveKey{key: initialKey, move: EncodeMove(queenPawnMove)}
+	otherKey := moveKey{key: initialKey - 1, move: 0x0001}
+	for _, data := range []data{
+		{
+			options: BuilderOptions{},
+			statistics: map[moveKey]MoveStatistics{
+				kingPawnKey:  {Wins: 1, Draws: 1, Losses: 5},
+				queenPawnKey: {Wins: 2, Draws: 1},
+				otherKey:     {Losses: 3},
+			},
+			wantEntries: []Entry{
+				{Key: initialKey, Move: EncodeMove(queenPawnMove), Weight: 5},
+				{Key: initialKey, Move: EncodeMove(kingPawnMove), Weight: 3},
+			},
+		},
+		{
+			options: BuilderOptions{MinimalGames: 4},
+			statistics: map[moveKey]MoveStatistics{
+				kingPawnKey:  {Wins: 1, Draws: 1, Losses: 5},
+				queenPawnKey: {Wins: 2, Draws: 1},
+			},
+			wantEntries: []Entry{
+				{Key: initialKey, Move: EncodeMove(kingPawnMove), Weight: 3},
+			},
+		},
+		// weights are scaled
+		{
+			options: BuilderOptions{},
+			statistics: map[moveKey]MoveStatistics{
+				kingPawnKey:  {Wins: 65535},
+				queenPawnKey: {Draws: 1},
+				otherKey:     {Wins: 32768},
+			},
+			wantEntries: []Entry{
+				{Key: initialKey - 1, Move: 0x0001, Weight: 32768},
+				{Key: initialKey, Move: EncodeMove(kingPawnMove), Weight: 65535},
+				{Key: initialKey, Move: EncodeMove(queenPawnMove), Weight: 1},
+			},
+		},
+		{
+			options:     BuilderOptions{},
+			statistics:  map[moveKey]MoveStatistics{},
+			wantEntries: nil,
+		},
+	} {
+		builder := NewBuilder(data.options)
+		builder.statistics = data.statistics
+
+		got := builder.Book()
+
+		if !reflect.DeepEqual(got.Entries(), data.wantEntries) {
+			test.Fail()
+		}
+	}
+}
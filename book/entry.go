@@ -0,0 +1,61 @@
+package book
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+const (
+	coordinateMask = 0x7
+	promotionMask  = 0x7
+	toFileShift    = 0
+	toRankShift    = 3
+	fromFileShift  = 6
+	fromRankShift  = 9
+	promotionShift = 12
+)
+
+// Entry ...
+//
+// It's an entry of a Polyglot book, which is stored in 16 bytes
+// in a big-endian order.
+type Entry struct {
+	Key    uint64
+	Move   uint16
+	Weight uint16
+	Learn  uint32
+}
+
+// EncodeMove ...
+//
+// It encodes a move in the Polyglot format without a promotion.
+func EncodeMove(move models.Move) uint16 {
+	// a position of a start is encoded in the same way, but with a shift
+	return encodePosition(move.Finish) | encodePosition(move.Start)<<fromFileShift
+}
+
+// DecodeMove ...
+//
+// It decodes a move from the Polyglot format. Piece storages don't support
+// promotions, so a move with a promotion isn't decoded.
+func DecodeMove(rawMove uint16) (move models.Move, ok bool) {
+	if (rawMove>>promotionShift)&promotionMask != 0 {
+		return models.Move{}, false
+	}
+
+	move = models.Move{
+		Start: models.Position{
+			File: int((rawMove >> fromFileShift) & coordinateMask),
+			Rank: int((rawMove >> fromRankShift) & coordinateMask),
+		},
+		Finish: models.Position{
+			File: int((rawMove >> toFileShift) & coordinateMask),
+			Rank: int((rawMove >> toRankShift) & coordinateMask),
+		},
+	}
+	return move, true
+}
+
+func encodePosition(position models.Position) uint16 {
+	return uint16(position.File)<<toFileShift |
+		uint16(position.Rank)<<toRankShift
+}
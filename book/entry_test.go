@@ -0,0 +1,64 @@
+package book
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestEncodeMove(test *testing.T) {
+	// e2e4
+	move := models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	}
+	gotRawMove := EncodeMove(move)
+
+	if gotRawMove != 0x031c {
+		test.Fail()
+	}
+}
+
+func TestDecodeMove(test *testing.T) {
+	type data struct {
+		rawMove  uint16
+		wantMove models.Move
+		wantOk   bool
+	}
+
+	for _, data := range []data{
+		// e2e4
+		{
+			rawMove: 0x031c,
+			wantMove: models.Move{
+				Start:  models.Position{File: 4, Rank: 1},
+				Finish: models.Position{File: 4, Rank: 3},
+			},
+			wantOk: true,
+		},
+		// h8a1
+		{
+			rawMove: 0x0fc0,
+			wantMove: models.Move{
+				Start:  models.Position{File: 7, Rank: 7},
+				Finish: models.Position{File: 0, Rank: 0},
+			},
+			wantOk: true,
+		},
+		// a7a8q
+		{
+			rawMove:  0x4c38,
+			wantMove: models.Move{},
+			wantOk:   false,
+		},
+	} {
+		gotMove, gotOk := DecodeMove(data.rawMove)
+
+		if gotMove != data.wantMove {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
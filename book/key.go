@@ -0,0 +1,94 @@
+package book
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+const (
+	castlingOffset = 768
+	turnOffset     = 780
+)
+
+type castling struct {
+	color        models.Color
+	rookPosition models.Position
+}
+
+// it's in an order of the Polyglot format
+// nolint: gochecknoglobals
+var castlings = []castling{
+	{models.White, models.Position{File: 7, Rank: 0}},
+	{models.White, models.Position{File: 0, Rank: 0}},
+	{models.Black, models.Position{File: 7, Rank: 7}},
+	{models.Black, models.Position{File: 0, Rank: 7}},
+}
+
+// Key ...
+//
+// It computes a Polyglot Zobrist key of a position on an 8x8 board.
+//
+// Piece storages don't keep castling rights and en passant squares, so
+// a castling right is inferred from a king and a rook on their initial
+// positions, and en passant isn't taken into account.
+func Key(storage models.PieceStorage, color models.Color) uint64 {
+	var key uint64
+	for _, piece := range storage.Pieces() {
+		key ^= random64[pieceOffset(piece)]
+	}
+
+	for index, castling := range castlings {
+		if hasCastling(storage, castling) {
+			key ^= random64[castlingOffset+index]
+		}
+	}
+
+	if color == models.White {
+		key ^= random64[turnOffset]
+	}
+
+	return key
+}
+
+func pieceOffset(piece models.Piece) int {
+	// kinds of pieces are in an order of the Polyglot format,
+	// where a black piece precedes a white one of the same kind
+	var kind int
+	switch piece.Kind() {
+	case models.Pawn:
+		kind = 0
+	case models.Knight:
+		kind = 1
+	case models.Bishop:
+		kind = 2
+	case models.Rook:
+		kind = 3
+	case models.Queen:
+		kind = 4
+	case models.King:
+		kind = 5
+	}
+
+	kind = 2 * kind
+	if piece.Color() == models.White {
+		kind++
+	}
+
+	position := piece.Position()
+	return 64*kind + 8*position.Rank + position.File
+}
+
+func hasCastling(storage models.PieceStorage, castling castling) bool {
+	kingPosition := models.Position{File: 4, Rank: castling.rookPosition.Rank}
+	return hasPiece(storage, models.King, castling.color, kingPosition) &&
+		hasPiece(storage, models.Rook, castling.color, castling.rookPosition)
+}
+
+func hasPiece(
+	storage models.PieceStorage,
+	kind models.Kind,
+	color models.Color,
+	position models.Position,
+) bool {
+	piece, ok := storage.Piece(position)
+	return ok && piece.Kind() == kind && piece.Color() == color
+}
@@ -0,0 +1,87 @@
+package book
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestKey(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		color      models.Color
+	}
+	type data struct {
+		args    args
+		wantKey uint64
+	}
+
+	// keys are from the specification of the Polyglot format
+	for _, data := range []data{
+		// initial position
+		{
+			args: args{
+				boardInFEN: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+				color:      models.White,
+			},
+			wantKey: 0x463b96181691fc9c,
+		},
+		// e2e4
+		{
+			args: args{
+				boardInFEN: "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR",
+				color:      models.Black,
+			},
+			wantKey: 0x823c9b50fd114196,
+		},
+		// e2e4 d7d5
+		{
+			args: args{
+				boardInFEN: "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR",
+				color:      models.White,
+			},
+			wantKey: 0x0756b94461c50fb0,
+		},
+		// e2e4 d7d5 e4e5
+		{
+			args: args{
+				boardInFEN: "rnbqkbnr/ppp1pppp/8/3pP3/8/8/PPPP1PPP/RNBQKBNR",
+				color:      models.Black,
+			},
+			wantKey: 0x662fafb965db29d4,
+		},
+		// e2e4 d7d5 e4e5 f7f5 e1e2 (without en passant)
+		{
+			args: args{
+				boardInFEN: "rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPPKPPP/RNBQ1BNR",
+				color:      models.Black,
+			},
+			wantKey: 0x652a607ca3f242c1,
+		},
+		// e2e4 d7d5 e4e5 f7f5 e1e2 e8f7
+		{
+			args: args{
+				boardInFEN: "rnbq1bnr/ppp1pkpp/8/3pPp2/8/8/PPPPKPPP/RNBQ1BNR",
+				color:      models.White,
+			},
+			wantKey: 0x00fdd303c946bdd9,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		gotKey := Key(storage, data.args.color)
+
+		if gotKey != data.wantKey {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package chessminimax
+
+import (
+	"github.com/thewizardplusplus/go-chess-minimax/book"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// BookSearcher ...
+//
+// On a root of a search, it returns a move of the opening book selected
+// by the selector, if there are legal ones; otherwise, it falls back
+// to the inner searcher. A book move has a zero score and a full quality.
+type BookSearcher struct {
+	*SearcherSetter
+	*ObserverSetter
+
+	openingBook book.Book
+	selector    book.MoveSelector
+}
+
+// NewBookSearcher ...
+//
+// A nil selector means book.SelectBestMove().
+func NewBookSearcher(
+	innerSearcher MoveSearcher,
+	openingBook book.Book,
+	selector book.MoveSelector,
+) BookSearcher {
+	if selector == nil {
+		selector = book.SelectBestMove
+	}
+
+	searcher := BookSearcher{
+		SearcherSetter: new(SearcherSetter),
+		ObserverSetter: new(ObserverSetter),
+
+		openingBook: openingBook,
+		selector:    selector,
+	}
+
+	searcher.SetSearcher(innerSearcher)
+
+	return searcher
+}
+
+// SetTerminator ...
+func (searcher BookSearcher) SetTerminator(
+	terminator terminators.SearchTerminator,
+) {
+	searcher.searcher.SetTerminator(terminator)
+}
+
+// SetObserver ...
+//
+// It also sets the observer to the inner searcher.
+func (searcher BookSearcher) SetObserver(
+	observer observers.SearchObserver,
+) {
+	searcher.ObserverSetter.SetObserver(observer)
+	setObserver(searcher.searcher, observer)
+}
+
+// SearchProgress ...
+func (searcher BookSearcher) SearchProgress(deep int) float64 {
+	return searcher.searcher.SearchProgress(deep)
+}
+
+// SearchMove ...
+func (searcher BookSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	if deep == 0 {
+		if move, ok := searcher.searchBookMove(storage, color); ok {
+			return moves.ScoredMove{Move: move, Quality: 1}, nil
+		}
+	}
+
+	return searcher.searcher.SearchMove(storage, color, deep, bounds)
+}
+
+func (searcher BookSearcher) searchBookMove(
+	storage models.PieceStorage,
+	color models.Color,
+) (move models.Move, ok bool) {
+	var legalMoves []book.WeightedMove
+	for _, move := range searcher.openingBook.Moves(storage, color) {
+		if checkLegalMove(storage, color, move.Move) == nil {
+			legalMoves = append(legalMoves, move)
+		}
+	}
+	if len(legalMoves) == 0 {
+		return models.Move{}, false
+	}
+
+	return searcher.selector(legalMoves).Move, true
+}
@@ -0,0 +1,201 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/book"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func makeTestBook(storage models.PieceStorage) book.Book {
+	key := book.Key(storage, models.White)
+	return book.NewBook([]book.Entry{
+		// e2e4
+		{
+			Key: key,
+			Move: book.EncodeMove(models.Move{
+				Start:  models.Position{File: 4, Rank: 1},
+				Finish: models.Position{File: 4, Rank: 3},
+			}),
+			Weight: 10,
+		},
+		// e2e5 (illegal)
+		{
+			Key: key,
+			Move: book.EncodeMove(models.Move{
+				Start:  models.Position{File: 4, Rank: 1},
+				Finish: models.Position{File: 4, Rank: 4},
+			}),
+			Weight: 100,
+		},
+	})
+}
+
+func TestNewBookSearcher(test *testing.T) {
+	var innerSearcher MockMoveSearcher
+	openingBook := book.NewBook(nil)
+	searcher := NewBookSearcher(innerSearcher, openingBook, nil)
+
+	if !reflect.DeepEqual(searcher.searcher, innerSearcher) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.openingBook, openingBook) {
+		test.Fail()
+	}
+	if searcher.selector == nil {
+		test.Fail()
+	}
+}
+
+func TestBookSearcherSetTerminator(test *testing.T) {
+	var gotTerminator terminators.SearchTerminator
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {
+			gotTerminator = terminator
+		},
+	}
+	searcher := NewBookSearcher(innerSearcher, book.NewBook(nil), nil)
+
+	terminator := terminators.NewDeepTerminator(2)
+	searcher.SetTerminator(terminator)
+
+	if !reflect.DeepEqual(gotTerminator, terminator) {
+		test.Fail()
+	}
+}
+
+func TestBookSearcherSetObserver(test *testing.T) {
+	observer := MockSearchObserver{events: new([]string)}
+	innerSearcher := NewAlphaBetaSearcher(
+		MockMoveGenerator{},
+		MockSearchTerminator{},
+		MockBoardEvaluator{},
+	)
+	searcher := NewBookSearcher(innerSearcher, book.NewBook(nil), nil)
+	searcher.SetObserver(observer)
+
+	if !reflect.DeepEqual(searcher.observer, observer) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(innerSearcher.observer, observer) {
+		test.Fail()
+	}
+}
+
+func TestBookSearcherSearchProgress(test *testing.T) {
+	innerSearcher := MockMoveSearcher{
+		searchProgress: func(deep int) float64 {
+			if deep != 2 {
+				test.Fail()
+			}
+
+			return 0.5
+		},
+	}
+	searcher := NewBookSearcher(innerSearcher, book.NewBook(nil), nil)
+	progress := searcher.SearchProgress(2)
+
+	if progress != 0.5 {
+		test.Fail()
+	}
+}
+
+func TestBookSearcherSearchMove(test *testing.T) {
+	initialStorage, err := uci.DecodePieceStorage(
+		moves.InitialBoardInFEN,
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	type args struct {
+		color models.Color
+		deep  int
+	}
+	type data struct {
+		args     args
+		wantMove moves.ScoredMove
+		wantErr  error
+	}
+
+	innerMove := moves.ScoredMove{
+		Move: models.Move{
+			Start:  models.Position{File: 6, Rank: 0},
+			Finish: models.Position{File: 5, Rank: 2},
+		},
+		Score:   2.3,
+		Quality: 0.5,
+	}
+	for _, data := range []data{
+		// a book move
+		{
+			args: args{color: models.White, deep: 0},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 4, Rank: 3},
+				},
+				Quality: 1,
+			},
+			wantErr: nil,
+		},
+		// a position missing in the book
+		{
+			args:     args{color: models.Black, deep: 0},
+			wantMove: innerMove,
+			wantErr:  nil,
+		},
+		// not a root
+		{
+			args:     args{color: models.White, deep: 1},
+			wantMove: innerMove,
+			wantErr:  ErrDraw,
+		},
+	} {
+		innerSearcher := MockMoveSearcher{
+			searchMove: func(
+				storage models.PieceStorage,
+				color models.Color,
+				deep int,
+				bounds moves.Bounds,
+			) (moves.ScoredMove, error) {
+				if !reflect.DeepEqual(storage, initialStorage) {
+					test.Fail()
+				}
+				if color != data.args.color {
+					test.Fail()
+				}
+				if deep != data.args.deep {
+					test.Fail()
+				}
+				if !reflect.DeepEqual(bounds, moves.NewBounds()) {
+					test.Fail()
+				}
+
+				return innerMove, data.wantErr
+			},
+		}
+		openingBook := makeTestBook(initialStorage)
+		searcher := NewBookSearcher(innerSearcher, openingBook, nil)
+		gotMove, gotErr := searcher.SearchMove(
+			initialStorage,
+			data.args.color,
+			data.args.deep,
+			moves.NewBounds(),
+		)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
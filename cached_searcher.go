@@ -3,6 +3,7 @@ package chessminimax
 import (
 	"github.com/thewizardplusplus/go-chess-minimax/caches"
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
@@ -10,6 +11,7 @@ import (
 // CachedSearcher ...
 type CachedSearcher struct {
 	*SearcherSetter
+	*ObserverSetter
 
 	cache caches.Cache
 }
@@ -23,6 +25,7 @@ func NewCachedSearcher(
 ) CachedSearcher {
 	searcher := CachedSearcher{
 		SearcherSetter: new(SearcherSetter),
+		ObserverSetter: new(ObserverSetter),
 
 		cache: cache,
 	}
@@ -42,27 +45,56 @@ func (searcher CachedSearcher) SetTerminator(
 	searcher.searcher.SetTerminator(terminator)
 }
 
+// SetObserver ...
+//
+// It also sets the observer to the inner searcher.
+func (searcher CachedSearcher) SetObserver(
+	observer observers.SearchObserver,
+) {
+	searcher.ObserverSetter.SetObserver(observer)
+	setObserver(searcher.searcher, observer)
+}
+
 // SearchProgress ...
 func (searcher CachedSearcher) SearchProgress(deep int) float64 {
 	return searcher.searcher.SearchProgress(deep)
 }
 
 // SearchMove ...
+//
+// A root restricted by a root generator of the inner searcher isn't cached,
+// because its result may differ from one of an unrestricted search.
 func (searcher CachedSearcher) SearchMove(
 	storage models.PieceStorage,
 	color models.Color,
 	deep int,
 	bounds moves.Bounds,
 ) (moves.ScoredMove, error) {
+	if deep == 0 && isRootRestricted(searcher.searcher) {
+		return searcher.searcher.SearchMove(storage, color, deep, bounds)
+	}
+
 	data, ok := searcher.cache.Get(storage, color)
 	moveQuality := evaluateQuality(searcher, deep)
 	if ok && data.Move.Quality >= moveQuality {
-		return data.Move, data.Error
+		// mate scores are stored relative to a node, so they should be adjusted
+		// to a current deep
+		data.Move.Score = moves.ScoreFromNode(data.Move.Score, deep)
+		// a score obtained with other bounds may be only a bound of a real one
+		if bounds.IsCompatible(data.Move.Score, data.Bound) {
+			searcher.currentObserver().CacheHit(deep)
+			return data.Move, data.Error
+		}
 	}
 
 	move, err := searcher.searcher.SearchMove(storage, color, deep, bounds)
 	if !move.Move.IsZero() {
-		data := moves.FailedMove{Move: move, Error: err}
+		data := moves.FailedMove{
+			Move:  move,
+			Error: err,
+			Bound: bounds.ScoreBound(move.Score),
+		}
+		data.Move.Score = moves.ScoreToNode(move.Score, deep)
 		searcher.cache.Set(storage, color, data)
 	}
 
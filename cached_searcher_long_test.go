@@ -7,9 +7,12 @@ import (
 	"testing"
 
 	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
 )
 
 func TestCachedSearcher(test *testing.T) {
@@ -179,3 +182,61 @@ func TestCachedSearcher(test *testing.T) {
 		}
 	}
 }
+
+func TestCachedSearcher_withRootGenerator(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"7K/8/7q/8/8/8/7Q/k7",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	kingMove := models.Move{
+		Start:  models.Position{File: 7, Rank: 7},
+		Finish: models.Position{File: 6, Rank: 7},
+	}
+	restrictedMove := moves.ScoredMove{Move: kingMove, Score: 0, Quality: 1}
+	unrestrictedMove := moves.ScoredMove{
+		Move: models.Move{
+			Start:  models.Position{File: 7, Rank: 1},
+			Finish: models.Position{File: 7, Rank: 5},
+		},
+		Score:   9,
+		Quality: 1,
+	}
+	for _, isRestrictedFirst := range []bool{false, true} {
+		var generator models.MoveGenerator
+		var evaluator evaluators.MaterialEvaluator
+		terminator := terminators.NewDeepTerminator(1)
+		innerSearcher := NewAlphaBetaSearcher(generator, terminator, evaluator)
+		cache := caches.NewStringHashingCache(1e6, uci.EncodePieceStorage)
+		searcher := NewCachedSearcher(innerSearcher, cache)
+
+		for _, isRestricted := range []bool{isRestrictedFirst, !isRestrictedFirst} {
+			wantMove := unrestrictedMove
+			innerSearcher.SetRootGenerator(nil)
+			if isRestricted {
+				wantMove = restrictedMove
+				innerSearcher.SetRootGenerator(
+					NewFilteredMoveGenerator(generator, []models.Move{kingMove}),
+				)
+			}
+
+			gotMove, gotErr := searcher.SearchMove(
+				storage,
+				models.White,
+				0, // initial deep
+				moves.NewBounds(),
+			)
+
+			if !reflect.DeepEqual(gotMove, wantMove) {
+				test.Fail()
+			}
+			if gotErr != nil {
+				test.Fail()
+			}
+		}
+	}
+}
@@ -499,6 +499,253 @@ func TestCachedSearcherSearchMove(test *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					searchProgress: func(deep int) float64 {
+						return 0.5
+					},
+				},
+				cache: MockCache{
+					get: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) (data moves.FailedMove, ok bool) {
+						// mate scores are stored relative to a node
+						data = moves.FailedMove{
+							Move: moves.ScoredMove{
+								Move: models.Move{
+									Start: models.Position{
+										File: 1,
+										Rank: 2,
+									},
+									Finish: models.Position{
+										File: 3,
+										Rank: 4,
+									},
+								},
+								Score:   -evaluateCheckmate(1),
+								Quality: 0.75,
+							},
+						}
+						return data, true
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+				bounds:  moves.Bounds{Alpha: -2e6, Beta: 3e6},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start: models.Position{
+						File: 1,
+						Rank: 2,
+					},
+					Finish: models.Position{
+						File: 3,
+						Rank: 4,
+					},
+				},
+				Score:   -evaluateCheckmate(3),
+				Quality: 0.75,
+			},
+			wantErr: false,
+		},
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					searchProgress: func(deep int) float64 {
+						return 0.5
+					},
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						move := moves.ScoredMove{
+							Move: models.Move{
+								Start: models.Position{
+									File: 5,
+									Rank: 6,
+								},
+								Finish: models.Position{
+									File: 7,
+									Rank: 8,
+								},
+							},
+							Score: evaluateCheckmate(4),
+						}
+						return move, nil
+					},
+				},
+				cache: MockCache{
+					get: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) (data moves.FailedMove, ok bool) {
+						return moves.FailedMove{}, false
+					},
+					set: func(
+						storage models.PieceStorage,
+						color models.Color,
+						data moves.FailedMove,
+					) {
+						// mate scores are stored relative to a node
+						expectedData := moves.FailedMove{
+							Move: moves.ScoredMove{
+								Move: models.Move{
+									Start: models.Position{
+										File: 5,
+										Rank: 6,
+									},
+									Finish: models.Position{
+										File: 7,
+										Rank: 8,
+									},
+								},
+								Score: evaluateCheckmate(2),
+							},
+						}
+						if !reflect.DeepEqual(data, expectedData) {
+							test.Fail()
+						}
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+				bounds:  moves.Bounds{Alpha: -2e6, Beta: 3e6},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start: models.Position{
+						File: 5,
+						Rank: 6,
+					},
+					Finish: models.Position{
+						File: 7,
+						Rank: 8,
+					},
+				},
+				Score: evaluateCheckmate(4),
+			},
+			wantErr: false,
+		},
+		// a cached score is only a lower bound and it's out of the bounds
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					searchProgress: func(deep int) float64 {
+						if deep != 2 {
+							test.Fail()
+						}
+
+						return 0.5
+					},
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						if !reflect.DeepEqual(bounds, moves.Bounds{Alpha: 1, Beta: 3}) {
+							test.Fail()
+						}
+
+						move := moves.ScoredMove{
+							Move: models.Move{
+								Start: models.Position{
+									File: 5,
+									Rank: 6,
+								},
+								Finish: models.Position{
+									File: 7,
+									Rank: 8,
+								},
+							},
+							Score: 0.5,
+						}
+						return move, nil
+					},
+				},
+				cache: MockCache{
+					get: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) (data moves.FailedMove, ok bool) {
+						data = moves.FailedMove{
+							Move: moves.ScoredMove{
+								Move: models.Move{
+									Start: models.Position{
+										File: 1,
+										Rank: 2,
+									},
+									Finish: models.Position{
+										File: 3,
+										Rank: 4,
+									},
+								},
+								Score:   2.3,
+								Quality: 0.75,
+							},
+							Bound: moves.LowerBound,
+						}
+						return data, true
+					},
+					set: func(
+						storage models.PieceStorage,
+						color models.Color,
+						data moves.FailedMove,
+					) {
+						expectedData := moves.FailedMove{
+							Move: moves.ScoredMove{
+								Move: models.Move{
+									Start: models.Position{
+										File: 5,
+										Rank: 6,
+									},
+									Finish: models.Position{
+										File: 7,
+										Rank: 8,
+									},
+								},
+								Score: 0.5,
+							},
+							Bound: moves.UpperBound,
+						}
+						if !reflect.DeepEqual(data, expectedData) {
+							test.Fail()
+						}
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+				bounds:  moves.Bounds{Alpha: 1, Beta: 3},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start: models.Position{
+						File: 5,
+						Rank: 6,
+					},
+					Finish: models.Position{
+						File: 7,
+						Rank: 8,
+					},
+				},
+				Score: 0.5,
+			},
+			wantErr: false,
+		},
 	} {
 		searcher := CachedSearcher{
 			SearcherSetter: &SearcherSetter{
@@ -523,3 +770,61 @@ func TestCachedSearcherSearchMove(test *testing.T) {
 		}
 	}
 }
+
+func TestCachedSearcherSetObserver(test *testing.T) {
+	observer := MockSearchObserver{events: new([]string)}
+	innerSearcher := NewAlphaBetaSearcher(
+		MockMoveGenerator{},
+		MockSearchTerminator{},
+		MockBoardEvaluator{},
+	)
+	searcher := NewCachedSearcher(innerSearcher, MockCache{})
+	searcher.SetObserver(observer)
+
+	if !reflect.DeepEqual(searcher.observer, observer) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(innerSearcher.observer, observer) {
+		test.Fail()
+	}
+}
+
+func TestCachedSearcherSearchMove_withObserver(test *testing.T) {
+	var events []string
+	searcher := CachedSearcher{
+		SearcherSetter: &SearcherSetter{
+			searcher: MockMoveSearcher{
+				searchProgress: func(deep int) float64 { return 0 },
+			},
+		},
+		ObserverSetter: &ObserverSetter{
+			observer: MockSearchObserver{events: &events},
+		},
+
+		cache: MockCache{
+			get: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) (move moves.FailedMove, ok bool) {
+				move = moves.FailedMove{
+					Move: moves.ScoredMove{
+						Move:    models.Move{Start: models.Position{File: 1}},
+						Score:   2.3,
+						Quality: 1,
+					},
+				}
+				return move, true
+			},
+		},
+	}
+	searcher.SearchMove( // nolint: errcheck
+		MockPieceStorage{},
+		models.White,
+		2,
+		moves.NewBounds(),
+	)
+
+	if !reflect.DeepEqual(events, []string{"CacheHit(2)"}) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,106 @@
+// Command bookbuilder builds an opening book in the Polyglot format
+// from games in the PGN format.
+//
+// Usage:
+//
+//	bookbuilder [options] file.pgn...
+//
+// Moves of games are replayed up to the first one, which can't be decoded
+// (e.g. castling), and games with an unknown result are skipped.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/thewizardplusplus/go-chess-minimax/book"
+	"github.com/thewizardplusplus/go-chess-minimax/pgn"
+)
+
+// nolint: gochecknoglobals
+var gameResults = map[string]book.GameResult{
+	pgn.WhiteWin: book.WhiteWin,
+	pgn.BlackWin: book.BlackWin,
+	pgn.Draw:     book.Draw,
+}
+
+func main() {
+	log.SetFlags(0)
+
+	var options book.BuilderOptions
+	flag.IntVar(&options.MaximalPly, "ply", 20, "maximal ply of moves")
+	flag.IntVar(
+		&options.MinimalGames,
+		"min-games",
+		1,
+		"minimal number of games with a move",
+	)
+	output := flag.String("output", "book.bin", "path to an output book")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("at least one PGN file is required")
+	}
+
+	builder := book.NewBuilder(options)
+	for _, path := range flag.Args() {
+		if err := addGamesFromFile(builder, path); err != nil {
+			log.Fatalf("unable to read the PGN file: %v", err)
+		}
+	}
+
+	if err := writeBook(*output, builder.Book()); err != nil {
+		log.Fatalf("unable to write the book: %v", err)
+	}
+}
+
+// the file is closed before a return, so files aren't kept open all together
+func addGamesFromFile(builder *book.Builder, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close() // nolint: errcheck
+
+	return addGames(builder, file)
+}
+
+func addGames(builder *book.Builder, reader io.Reader) error {
+	pgnReader := pgn.NewReader(reader)
+	for {
+		game, err := pgnReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read the game: %v", err)
+		}
+
+		storage, color, moves, err := game.DecodeMoves()
+		if err != nil {
+			if _, ok := err.(pgn.MoveError); !ok {
+				return err
+			}
+		}
+
+		builder.AddGame(storage, color, moves, gameResults[game.Result])
+	}
+}
+
+// an error of closing the file is checked, because it may mean
+// that the book isn't completely written
+func writeBook(path string, openingBook book.Book) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := book.WriteBook(file, openingBook); err != nil {
+		file.Close() // nolint: errcheck
+		return err
+	}
+
+	return file.Close()
+}
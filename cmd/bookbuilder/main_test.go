@@ -0,0 +1,144 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/book"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestAddGames(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// e2e4
+	kingPawnMove := models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	}
+	// e7e5
+	kingPawnReply := models.Move{
+		Start:  models.Position{File: 4, Rank: 6},
+		Finish: models.Position{File: 4, Rank: 4},
+	}
+	initialKey := book.Key(storage, models.White)
+	replyKey := book.Key(storage.ApplyMove(kingPawnMove), models.Black)
+
+	type data struct {
+		texts       []string
+		wantEntries []book.Entry
+		wantErr     bool
+	}
+
+	for _, data := range []data{
+		{
+			texts: []string{
+				"1. e4 e5 2. Nf3 1-0 1. e4 e5 1/2-1/2",
+				// castling isn't supported, so moves before it are used only
+				"1. d4 O-O 2. c4 0-1 1. e4 *",
+			},
+			wantEntries: book.NewBook([]book.Entry{
+				{Key: initialKey, Move: book.EncodeMove(kingPawnMove), Weight: 3},
+				{Key: replyKey, Move: book.EncodeMove(kingPawnReply), Weight: 1},
+			}).Entries(),
+			wantErr: false,
+		},
+		{
+			texts:       []string{"1. e4 ) *"},
+			wantEntries: nil,
+			wantErr:     true,
+		},
+	} {
+		builder := book.NewBuilder(book.BuilderOptions{MaximalPly: 2})
+		var gotErr error
+		for _, text := range data.texts {
+			if gotErr = addGames(builder, strings.NewReader(text)); gotErr != nil {
+				break
+			}
+		}
+
+		if gotErr == nil &&
+			!reflect.DeepEqual(builder.Book().Entries(), data.wantEntries) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestAddGamesFromFile(test *testing.T) {
+	directory, err := ioutil.TempDir("", "bookbuilder")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	path := filepath.Join(directory, "games.pgn")
+	err = ioutil.WriteFile(path, []byte("1. e4 e5 1-0"), 0644)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	builder := book.NewBuilder(book.BuilderOptions{})
+	if err := addGamesFromFile(builder, path); err != nil {
+		test.Fail()
+	}
+	if len(builder.Book().Entries()) != 1 {
+		test.Fail()
+	}
+
+	missingPath := filepath.Join(directory, "missing.pgn")
+	if err := addGamesFromFile(builder, missingPath); err == nil {
+		test.Fail()
+	}
+}
+
+func TestWriteBook(test *testing.T) {
+	directory, err := ioutil.TempDir("", "bookbuilder")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	openingBook := book.NewBook([]book.Entry{
+		{Key: 23, Move: 42, Weight: 1},
+		{Key: 42, Move: 23, Weight: 2},
+	})
+	path := filepath.Join(directory, "book.bin")
+	if err := writeBook(path, openingBook); err != nil {
+		test.Fail()
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer file.Close() // nolint: errcheck
+
+	gotBook, err := book.ReadBook(file)
+	if err != nil {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(gotBook.Entries(), openingBook.Entries()) {
+		test.Fail()
+	}
+
+	missingPath := filepath.Join(directory, "missing", "book.bin")
+	if err := writeBook(missingPath, openingBook); err == nil {
+		test.Fail()
+	}
+}
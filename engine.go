@@ -0,0 +1,355 @@
+package chessminimax
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// ErrIllegalMove ...
+var ErrIllegalMove = errors.New("illegal move")
+
+// EngineTimeControl ...
+//
+// All its limits are optional; a search is terminated by a first reached one.
+// Without any limits a search is terminated only by Engine.Stop().
+type EngineTimeControl struct {
+	MaximalDeep int
+	MoveTime    time.Duration
+
+	// if remaining time isn't zero, it's a tournament time control
+	// (see terminators.TournamentTerminator)
+	RemainingTime time.Duration
+	Increment     time.Duration
+	MovesToGo     int
+}
+
+// EngineOptions ...
+type EngineOptions struct {
+	// if it's less than two, a search isn't parallel
+	Concurrency int
+	// if it's zero, a cache isn't used
+	CacheSize   int
+	TimeControl EngineTimeControl
+	// if it's nil, time.Now() is used
+	Clock terminators.Clock
+}
+
+// Engine ...
+//
+// It's a facade, which tracks a game and searches moves in it with searchers
+// wired by options. A cache is shared between searches of a game.
+//
+// Its methods, except Stop(), should be called from a single goroutine.
+type Engine struct {
+	options EngineOptions
+	cache   caches.Cache
+
+	initialBoardInFEN string
+	initialColor      models.Color
+	storage           models.PieceStorage
+	color             models.Color
+	moveHistory       []models.Move
+
+	lock    sync.Mutex
+	stopper *terminators.ManualTerminator
+}
+
+// it terminates iterative deepening after the specified deep
+type iterationDeepTerminator struct {
+	maximalDeep int
+}
+
+// NewEngine ...
+//
+// It starts a game from the initial position with white to move.
+func NewEngine(options EngineOptions) *Engine {
+	if options.Clock == nil {
+		options.Clock = time.Now
+	}
+
+	engine := &Engine{options: options}
+	if err := engine.NewGame(moves.InitialBoardInFEN, models.White); err != nil {
+		// the initial board is always correct
+		panic(err)
+	}
+
+	return engine
+}
+
+// NewGame ...
+//
+// It starts a new game from the passed board and clears the cache.
+func (engine *Engine) NewGame(boardInFEN string, color models.Color) error {
+	storage, err :=
+		uci.DecodePieceStorage(boardInFEN, pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		return err
+	}
+
+	engine.initialBoardInFEN = boardInFEN
+	engine.initialColor = color
+	engine.storage = storage
+	engine.color = color
+	engine.moveHistory = nil
+	engine.cache = nil
+	if engine.options.CacheSize != 0 {
+		cache := caches.NewStringHashingCache(
+			engine.options.CacheSize,
+			uci.EncodePieceStorage,
+		)
+		engine.cache = caches.NewParallelCache(cache)
+	}
+
+	return nil
+}
+
+// SetTimeControl ...
+//
+// It's used for following searches, e.g. for updating remaining time.
+func (engine *Engine) SetTimeControl(timeControl EngineTimeControl) {
+	engine.options.TimeControl = timeControl
+}
+
+// InitialBoardInFEN ...
+func (engine *Engine) InitialBoardInFEN() string {
+	return engine.initialBoardInFEN
+}
+
+// InitialColor ...
+func (engine *Engine) InitialColor() models.Color {
+	return engine.initialColor
+}
+
+// Storage ...
+//
+// It returns a current position.
+func (engine *Engine) Storage() models.PieceStorage {
+	return engine.storage
+}
+
+// Color ...
+//
+// It returns a side to move.
+func (engine *Engine) Color() models.Color {
+	return engine.color
+}
+
+// MoveHistory ...
+func (engine *Engine) MoveHistory() []models.Move {
+	return append([]models.Move(nil), engine.moveHistory...)
+}
+
+// ApplyMove ...
+//
+// It returns ErrIllegalMove, if the move isn't legal in a current position.
+func (engine *Engine) ApplyMove(move models.Move) error {
+	if err := checkLegalMove(engine.storage, engine.color, move); err != nil {
+		return err
+	}
+
+	engine.storage = engine.storage.ApplyMove(move)
+	engine.color = engine.color.Negative()
+	engine.moveHistory = append(engine.moveHistory, move)
+
+	return nil
+}
+
+// BestMove ...
+//
+// It searches a best move in a current position. It returns ErrCheckmate
+// or ErrDraw, if a game is over.
+func (engine *Engine) BestMove() (moves.ScoredMove, error) {
+	stopper := engine.startSearch()
+	defer engine.finishSearch()
+
+	terminator := engine.makeTerminator(stopper)
+	searcher := engine.makeSearcher(terminator)
+	return searcher.SearchMove(
+		engine.storage,
+		engine.color,
+		0,
+		moves.NewBounds(),
+	)
+}
+
+// Analyze ...
+//
+// It runs an infinite analysis of a current position until Stop() is called
+// (see Analyzer). The time control is ignored.
+func (engine *Engine) Analyze(
+	handler AnalysisHandler,
+) (moves.ScoredMove, error) {
+	stopper := engine.startSearch()
+	defer engine.finishSearch()
+
+	searcher := engine.makeSearcher(stopper)
+	analyzer := NewAnalyzer(searcher, engine.cache, engine.options.Clock)
+	return analyzer.Analyze(engine.storage, engine.color, stopper, handler)
+}
+
+// Stop ...
+//
+// It terminates a current search, if any.
+//
+// It's safe for concurrent use.
+func (engine *Engine) Stop() {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+
+	if engine.stopper != nil {
+		engine.stopper.Terminate()
+	}
+}
+
+func (engine *Engine) startSearch() *terminators.ManualTerminator {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+
+	engine.stopper = new(terminators.ManualTerminator)
+	return engine.stopper
+}
+
+func (engine *Engine) finishSearch() {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+
+	engine.stopper = nil
+}
+
+func (engine *Engine) makeTerminator(
+	stopper *terminators.ManualTerminator,
+) terminators.SearchTerminator {
+	timeControl := engine.options.TimeControl
+	terminatorGroup := []terminators.SearchTerminator{stopper}
+	if timeControl.MaximalDeep != 0 {
+		terminatorGroup = append(
+			terminatorGroup,
+			iterationDeepTerminator{timeControl.MaximalDeep},
+		)
+	}
+	if timeControl.MoveTime != 0 {
+		terminatorGroup = append(
+			terminatorGroup,
+			terminators.NewTimeTerminator(
+				engine.options.Clock,
+				timeControl.MoveTime,
+			),
+		)
+	}
+	if timeControl.RemainingTime != 0 {
+		terminatorGroup = append(
+			terminatorGroup,
+			terminators.NewTournamentTerminator(
+				engine.options.Clock,
+				terminators.TournamentOptions{
+					RemainingTime: timeControl.RemainingTime,
+					Increment:     timeControl.Increment,
+					MovesToGo:     timeControl.MovesToGo,
+					MoveNumber:    engine.moveNumber(),
+				},
+			),
+		)
+	}
+
+	return terminators.NewGroupTerminator(terminatorGroup...)
+}
+
+// it returns a number of a current full move, which is incremented
+// after a move of black
+func (engine *Engine) moveNumber() int {
+	plies := len(engine.moveHistory)
+	if engine.initialColor == models.Black {
+		plies++
+	}
+
+	return plies/2 + 1
+}
+
+func (engine *Engine) makeSearcher(
+	terminator terminators.SearchTerminator,
+) MoveSearcher {
+	layers := []LayerConfig{{Type: AlphaBetaLayer}}
+	if engine.cache != nil {
+		layers = append(layers, LayerConfig{Type: CacheLayer})
+	}
+	layers = append(layers, LayerConfig{Type: IterativeLayer})
+
+	var evaluator evaluators.MaterialEvaluator
+	if engine.options.Concurrency < 2 {
+		searcher := buildWorker(layers, 0, evaluator, engine.cache)
+		searcher.SetTerminator(terminator)
+
+		return searcher
+	}
+
+	return NewParallelSearcher(
+		terminator,
+		engine.options.Concurrency,
+		func(index int) MoveSearcher {
+			return buildWorker(layers, index, evaluator, engine.cache)
+		},
+		SelectDeepestResult,
+	)
+}
+
+// IsSearchTerminated ...
+func (terminator iterationDeepTerminator) IsSearchTerminated(deep int) bool {
+	return false
+}
+
+// SearchProgress ...
+func (terminator iterationDeepTerminator) SearchProgress(deep int) float64 {
+	return 0
+}
+
+// IsIterationTerminated ...
+func (terminator iterationDeepTerminator) IsIterationTerminated(
+	deep int,
+) bool {
+	return deep > terminator.maximalDeep
+}
+
+// CompleteIteration ...
+func (terminator iterationDeepTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+}
+
+// it returns models.ErrKingCapture, if an opponent king is under an attack,
+// or ErrIllegalMove
+func checkLegalMove(
+	storage models.PieceStorage,
+	color models.Color,
+	move models.Move,
+) error {
+	var generator models.MoveGenerator
+	moveGroup, err := moves.LegalMoves(generator, storage, color)
+	if err != nil {
+		return err
+	}
+	if !containsMove(moveGroup, move) {
+		return ErrIllegalMove
+	}
+
+	return nil
+}
+
+func containsMove(moveGroup []models.Move, move models.Move) bool {
+	for _, candidate := range moveGroup {
+		if candidate == move {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+// +build long
+
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestEngineBestMove(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		color      models.Color
+		options    EngineOptions
+	}
+	type data struct {
+		args     args
+		wantMove moves.ScoredMove
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: "7K/8/7q/8/8/8/8/k7",
+				color:      models.White,
+				options: EngineOptions{
+					TimeControl: EngineTimeControl{MaximalDeep: 1},
+				},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start:  models.Position{File: 7, Rank: 7},
+					Finish: models.Position{File: 6, Rank: 7},
+				},
+				Score: -9,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "7K/8/7q/8/8/8/8/k7",
+				color:      models.White,
+				options: EngineOptions{
+					Concurrency: 4,
+					CacheSize:   1e6,
+					TimeControl: EngineTimeControl{
+						MaximalDeep: 1,
+						MoveTime:    time.Minute,
+					},
+				},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start:  models.Position{File: 7, Rank: 7},
+					Finish: models.Position{File: 6, Rank: 7},
+				},
+				Score: -9,
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "6K1/8/7q/6p1/8/2B5/pp4PQ/k7",
+				color:      models.White,
+				options: EngineOptions{
+					CacheSize:   1e6,
+					TimeControl: EngineTimeControl{MaximalDeep: 2},
+				},
+			},
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start:  models.Position{File: 7, Rank: 1},
+					Finish: models.Position{File: 6, Rank: 0},
+				},
+				Score: -evaluateCheckmate(1),
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "6BK/8/8/8/8/pp6/k6R/7R",
+				color:      models.Black,
+				options: EngineOptions{
+					TimeControl: EngineTimeControl{MaximalDeep: 1},
+				},
+			},
+			wantMove: moves.ScoredMove{Score: evaluateCheckmate(0)},
+			wantErr:  ErrCheckmate,
+		},
+	} {
+		engine := NewEngine(data.args.options)
+		err := engine.NewGame(data.args.boardInFEN, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		gotMove, gotErr := engine.BestMove()
+
+		// a quality depends on a time of a search
+		if gotMove.Move != data.wantMove.Move ||
+			gotMove.Score != data.wantMove.Score {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEngineAnalyze(test *testing.T) {
+	engine := NewEngine(EngineOptions{CacheSize: 1e6})
+	err := engine.NewGame("kn6/n6q/PP6/8/8/8/7P/7K", models.White)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var deeps []int
+	gotMove, gotErr := engine.Analyze(func(update AnalysisUpdate) {
+		deeps = append(deeps, update.Deep)
+
+		// the analysis is infinite, so it should be stopped manually
+		if update.Deep == 3 {
+			engine.Stop()
+		}
+	})
+
+	if !reflect.DeepEqual(deeps, []int{1, 2, 3}) {
+		test.Fail()
+	}
+
+	wantMove := models.Move{
+		Start:  models.Position{File: 1, Rank: 5},
+		Finish: models.Position{File: 1, Rank: 6},
+	}
+	if gotMove.Move != wantMove || gotMove.Score != -4 {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,240 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+func TestNewEngine(test *testing.T) {
+	engine := NewEngine(EngineOptions{CacheSize: 10})
+
+	if engine.options.Clock == nil {
+		test.Fail()
+	}
+	if engine.cache == nil {
+		test.Fail()
+	}
+	if engine.InitialBoardInFEN() != moves.InitialBoardInFEN {
+		test.Fail()
+	}
+	if engine.InitialColor() != models.White || engine.Color() != models.White {
+		test.Fail()
+	}
+	if uci.EncodePieceStorage(engine.Storage()) != moves.InitialBoardInFEN {
+		test.Fail()
+	}
+	if len(engine.MoveHistory()) != 0 {
+		test.Fail()
+	}
+}
+
+func TestEngineNewGame(test *testing.T) {
+	engine := NewEngine(EngineOptions{})
+	engine.ApplyMove(models.Move{ // nolint: errcheck
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	})
+
+	err := engine.NewGame("7K/8/7q/8/8/8/8/k7", models.Black)
+	if err != nil {
+		test.Fail()
+	}
+	if engine.InitialBoardInFEN() != "7K/8/7q/8/8/8/8/k7" {
+		test.Fail()
+	}
+	if engine.InitialColor() != models.Black || engine.Color() != models.Black {
+		test.Fail()
+	}
+	if uci.EncodePieceStorage(engine.Storage()) != "7K/8/7q/8/8/8/8/k7" {
+		test.Fail()
+	}
+	if len(engine.MoveHistory()) != 0 {
+		test.Fail()
+	}
+	if engine.cache != nil {
+		test.Fail()
+	}
+
+	err = engine.NewGame("incorrect", models.White)
+	if err == nil {
+		test.Fail()
+	}
+}
+
+func TestEngineApplyMove(test *testing.T) {
+	type data struct {
+		boardInFEN  string
+		move        models.Move
+		wantFEN     string
+		wantHistory []models.Move
+		wantErr     error
+	}
+
+	for _, data := range []data{
+		// legal move
+		{
+			boardInFEN: "K7/8/8/8/8/8/8/k7",
+			move: models.Move{
+				Start:  models.Position{File: 0, Rank: 0},
+				Finish: models.Position{File: 1, Rank: 0},
+			},
+			wantFEN: "K7/8/8/8/8/8/8/1k6",
+			wantHistory: []models.Move{
+				{
+					Start:  models.Position{File: 0, Rank: 0},
+					Finish: models.Position{File: 1, Rank: 0},
+				},
+			},
+			wantErr: nil,
+		},
+		// move of an opponent piece
+		{
+			boardInFEN: "K7/8/8/8/8/8/8/k7",
+			move: models.Move{
+				Start:  models.Position{File: 0, Rank: 7},
+				Finish: models.Position{File: 1, Rank: 7},
+			},
+			wantFEN:     "K7/8/8/8/8/8/8/k7",
+			wantHistory: nil,
+			wantErr:     ErrIllegalMove,
+		},
+		// move to a check
+		{
+			boardInFEN: "K7/8/8/8/8/8/8/k6R",
+			move: models.Move{
+				Start:  models.Position{File: 0, Rank: 0},
+				Finish: models.Position{File: 1, Rank: 0},
+			},
+			wantFEN:     "K7/8/8/8/8/8/8/k6R",
+			wantHistory: nil,
+			wantErr:     ErrIllegalMove,
+		},
+	} {
+		engine := NewEngine(EngineOptions{})
+		if err := engine.NewGame(data.boardInFEN, models.Black); err != nil {
+			test.Fatal(err)
+		}
+
+		gotErr := engine.ApplyMove(data.move)
+
+		if uci.EncodePieceStorage(engine.Storage()) != data.wantFEN {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(engine.MoveHistory(), data.wantHistory) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+		if gotErr == nil && engine.Color() != models.White {
+			test.Fail()
+		}
+	}
+}
+
+func TestEngineStop(test *testing.T) {
+	engine := NewEngine(EngineOptions{})
+	engine.Stop() // it should do nothing without a search
+
+	stopper := engine.startSearch()
+	engine.Stop()
+	if !stopper.IsSearchTerminated(0) {
+		test.Fail()
+	}
+
+	engine.finishSearch()
+	if engine.stopper != nil {
+		test.Fail()
+	}
+}
+
+func TestEngineMakeTerminator(test *testing.T) {
+	clock := func() time.Time { return time.Time{} }
+	engine := NewEngine(EngineOptions{
+		TimeControl: EngineTimeControl{
+			MaximalDeep:   3,
+			MoveTime:      time.Second,
+			RemainingTime: time.Minute,
+		},
+		Clock: clock,
+	})
+	stopper := new(terminators.ManualTerminator)
+	terminator := engine.makeTerminator(stopper)
+
+	if terminator.IsSearchTerminated(10) {
+		test.Fail()
+	}
+	if terminators.IsIterationTerminated(terminator, 3) {
+		test.Fail()
+	}
+	if !terminators.IsIterationTerminated(terminator, 4) {
+		test.Fail()
+	}
+
+	stopper.Terminate()
+	if !terminator.IsSearchTerminated(0) {
+		test.Fail()
+	}
+}
+
+func TestEngineMoveNumber(test *testing.T) {
+	engine := NewEngine(EngineOptions{})
+	if engine.moveNumber() != 1 {
+		test.Fail()
+	}
+
+	engine.ApplyMove(models.Move{ // nolint: errcheck
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	})
+	if engine.moveNumber() != 1 {
+		test.Fail()
+	}
+
+	engine.ApplyMove(models.Move{ // nolint: errcheck
+		Start:  models.Position{File: 4, Rank: 6},
+		Finish: models.Position{File: 4, Rank: 4},
+	})
+	if engine.moveNumber() != 2 {
+		test.Fail()
+	}
+
+	engine.NewGame(moves.InitialBoardInFEN, models.Black) // nolint: errcheck
+	if engine.moveNumber() != 1 {
+		test.Fail()
+	}
+
+	engine.ApplyMove(models.Move{ // nolint: errcheck
+		Start:  models.Position{File: 4, Rank: 6},
+		Finish: models.Position{File: 4, Rank: 4},
+	})
+	if engine.moveNumber() != 2 {
+		test.Fail()
+	}
+}
+
+func TestIterationDeepTerminator(test *testing.T) {
+	terminator := iterationDeepTerminator{maximalDeep: 2}
+
+	if terminator.IsSearchTerminated(5) {
+		test.Fail()
+	}
+	if terminator.SearchProgress(5) != 0 {
+		test.Fail()
+	}
+	if terminator.IsIterationTerminated(2) {
+		test.Fail()
+	}
+	if !terminator.IsIterationTerminated(3) {
+		test.Fail()
+	}
+
+	// it should do nothing
+	terminator.CompleteIteration(2, moves.FailedMove{})
+}
@@ -109,8 +109,9 @@ func ExampleParallelSearcher() {
 	searcher := minimax.NewParallelSearcher(
 		terminator,
 		runtime.NumCPU(),
-		func() minimax.MoveSearcher {
-			var generator models.MoveGenerator
+		func(index int) minimax.MoveSearcher {
+			// try moves in different orders in different workers
+			generator := minimax.NewRotatedMoveGenerator(models.MoveGenerator{}, index)
 			var evaluator evaluators.MaterialEvaluator
 			innerSearcher := minimax.NewAlphaBetaSearcher(
 				generator,
@@ -126,6 +127,7 @@ func ExampleParallelSearcher() {
 				nil, // terminator will be set automatically by the parallel searcher
 			)
 		},
+		minimax.SelectDeepestResult,
 	)
 
 	scoredMove, err :=
@@ -138,3 +140,90 @@ func ExampleParallelSearcher() {
 
 	// Output: {Move:{Start:{File:7 Rank:7} Finish:{File:6 Rank:7}} Score:-9 Quality:1}
 }
+
+func ExampleEngine() {
+	engine := minimax.NewEngine(minimax.EngineOptions{
+		Concurrency: runtime.NumCPU(),
+		CacheSize:   1e6,
+		TimeControl: minimax.EngineTimeControl{MaximalDeep: 1},
+	})
+	if err := engine.NewGame("7K/8/7q/8/8/8/8/k7", models.White); err != nil {
+		log.Fatal(err)
+	}
+
+	scoredMove, err := engine.BestMove()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%+v\n", scoredMove.Move)
+
+	// Output: {Start:{File:7 Rank:7} Finish:{File:6 Rank:7}}
+}
+
+func ExampleBuildSearcher() {
+	storage, err :=
+		uci.DecodePieceStorage("7K/8/7q/8/8/8/8/k7", pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config, err := minimax.ParseSearcherConfig([]byte(`{
+		"evaluator": "material",
+		"layers": [
+			{"type": "alpha_beta"},
+			{"type": "cache", "cache_type": "parallel", "cache_size": 1000000},
+			{"type": "iterative"},
+			{"type": "parallel", "concurrency": 4, "selector": "deepest"}
+		],
+		"terminators": [
+			{"type": "deep", "deep": 2},
+			{"type": "time", "duration": "10s"}
+		]
+	}`))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	searcher, err := minimax.BuildSearcher(config, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scoredMove, err :=
+		searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%+v\n", scoredMove.Move)
+
+	// Output: {Start:{File:7 Rank:7} Finish:{File:6 Rank:7}}
+}
+
+func ExampleSearcherBuilder() {
+	storage, err :=
+		uci.DecodePieceStorage("7K/8/7q/8/8/8/8/k7", pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	searcher, err := minimax.NewSearcherBuilder().
+		WithCache(minimax.StringHashingCacheType, 1e6).
+		WithIterativeDeepening(0, 0).
+		WithMaximalDeep(2).
+		Build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scoredMove, err :=
+		searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%+v\n", scoredMove.Move)
+
+	// Output: {Start:{File:7 Rank:7} Finish:{File:6 Rank:7}}
+}
@@ -0,0 +1,55 @@
+package chessminimax
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// FilteredMoveGenerator ...
+//
+// It passes only allowed moves generated by an inner generator,
+// keeping their original order.
+type FilteredMoveGenerator struct {
+	innerGenerator MoveGenerator
+	allowedMoves   []models.Move
+}
+
+// NewFilteredMoveGenerator ...
+func NewFilteredMoveGenerator(
+	innerGenerator MoveGenerator,
+	allowedMoves []models.Move,
+) FilteredMoveGenerator {
+	return FilteredMoveGenerator{
+		innerGenerator: innerGenerator,
+		allowedMoves:   allowedMoves,
+	}
+}
+
+// MovesForColor ...
+func (generator FilteredMoveGenerator) MovesForColor(
+	storage models.PieceStorage,
+	color models.Color,
+) ([]models.Move, error) {
+	moveGroup, err := generator.innerGenerator.MovesForColor(storage, color)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredMoves []models.Move
+	for _, move := range moveGroup {
+		if generator.isAllowed(move) {
+			filteredMoves = append(filteredMoves, move)
+		}
+	}
+
+	return filteredMoves, nil
+}
+
+func (generator FilteredMoveGenerator) isAllowed(move models.Move) bool {
+	for _, allowedMove := range generator.allowedMoves {
+		if move == allowedMove {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,178 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewFilteredMoveGenerator(test *testing.T) {
+	var innerGenerator MockMoveGenerator
+	allowedMoves := []models.Move{
+		{
+			Start:  models.Position{File: 1, Rank: 2},
+			Finish: models.Position{File: 3, Rank: 4},
+		},
+	}
+	generator := NewFilteredMoveGenerator(innerGenerator, allowedMoves)
+
+	if !reflect.DeepEqual(generator.innerGenerator, innerGenerator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(generator.allowedMoves, allowedMoves) {
+		test.Fail()
+	}
+}
+
+func TestFilteredMoveGeneratorMovesForColor(test *testing.T) {
+	type fields struct {
+		innerGenerator MoveGenerator
+		allowedMoves   []models.Move
+	}
+	type args struct {
+		storage models.PieceStorage
+		color   models.Color
+	}
+	type data struct {
+		fields    fields
+		args      args
+		wantMoves []models.Move
+		wantErr   error
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				innerGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+
+						return nil, models.ErrKingCapture
+					},
+				},
+				allowedMoves: []models.Move{
+					{
+						Start:  models.Position{File: 1, Rank: 2},
+						Finish: models.Position{File: 3, Rank: 4},
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: nil,
+			wantErr:   models.ErrKingCapture,
+		},
+		{
+			fields: fields{
+				innerGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+
+						moves := []models.Move{
+							{
+								Start:  models.Position{File: 1, Rank: 2},
+								Finish: models.Position{File: 3, Rank: 4},
+							},
+							{
+								Start:  models.Position{File: 5, Rank: 6},
+								Finish: models.Position{File: 7, Rank: 8},
+							},
+							{
+								Start:  models.Position{File: 9, Rank: 10},
+								Finish: models.Position{File: 11, Rank: 12},
+							},
+						}
+						return moves, nil
+					},
+				},
+				allowedMoves: []models.Move{
+					{
+						Start:  models.Position{File: 9, Rank: 10},
+						Finish: models.Position{File: 11, Rank: 12},
+					},
+					{
+						Start:  models.Position{File: 1, Rank: 2},
+						Finish: models.Position{File: 3, Rank: 4},
+					},
+					{
+						Start:  models.Position{File: 13, Rank: 14},
+						Finish: models.Position{File: 15, Rank: 16},
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: []models.Move{
+				{
+					Start:  models.Position{File: 1, Rank: 2},
+					Finish: models.Position{File: 3, Rank: 4},
+				},
+				{
+					Start:  models.Position{File: 9, Rank: 10},
+					Finish: models.Position{File: 11, Rank: 12},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			fields: fields{
+				innerGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						moves := []models.Move{
+							{
+								Start:  models.Position{File: 1, Rank: 2},
+								Finish: models.Position{File: 3, Rank: 4},
+							},
+						}
+						return moves, nil
+					},
+				},
+				allowedMoves: nil,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: nil,
+			wantErr:   nil,
+		},
+	} {
+		generator := FilteredMoveGenerator{
+			innerGenerator: data.fields.innerGenerator,
+			allowedMoves:   data.fields.allowedMoves,
+		}
+		gotMoves, gotErr :=
+			generator.MovesForColor(data.args.storage, data.args.color)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
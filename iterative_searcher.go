@@ -2,18 +2,27 @@ package chessminimax
 
 import (
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
 
 // IterativeSearcher ...
+//
+// It supports the terminators.IterationTerminator interface
+// of its terminator.
 type IterativeSearcher struct {
 	*SearcherSetter
 	*TerminatorSetter
+	*DeepScheduleSetter
+	*ObserverSetter
+
+	completedDeep *int
 }
 
 const (
-	initialDeep = 1
+	defaultInitialDeep = 1
+	defaultDeepStep    = 1
 )
 
 // NewIterativeSearcher ...
@@ -22,16 +31,40 @@ func NewIterativeSearcher(
 	terminator terminators.SearchTerminator,
 ) IterativeSearcher {
 	searcher := IterativeSearcher{
-		SearcherSetter:   new(SearcherSetter),
-		TerminatorSetter: new(TerminatorSetter),
+		SearcherSetter:     new(SearcherSetter),
+		TerminatorSetter:   new(TerminatorSetter),
+		DeepScheduleSetter: new(DeepScheduleSetter),
+		ObserverSetter:     new(ObserverSetter),
+
+		completedDeep: new(int),
 	}
 
 	searcher.SetSearcher(innerSearcher)
 	searcher.SetTerminator(terminator)
+	searcher.SetDeepSchedule(defaultInitialDeep, defaultDeepStep)
 
 	return searcher
 }
 
+// SetObserver ...
+//
+// It also sets the observer to the inner searcher.
+func (searcher IterativeSearcher) SetObserver(
+	observer observers.SearchObserver,
+) {
+	searcher.ObserverSetter.SetObserver(observer)
+	setObserver(searcher.searcher, observer)
+}
+
+// CompletedDeep ...
+//
+// It returns a deep of an iteration, which result was returned
+// by a last search. It's zero, if the search hasn't completed any iteration
+// (i.e. a first one was aborted).
+func (searcher IterativeSearcher) CompletedDeep() int {
+	return *searcher.completedDeep
+}
+
 // SearchMove ...
 func (searcher IterativeSearcher) SearchMove(
 	storage models.PieceStorage,
@@ -40,15 +73,32 @@ func (searcher IterativeSearcher) SearchMove(
 	bounds moves.Bounds,
 ) (moves.ScoredMove, error) {
 	var lastMove moves.FailedMove
-	for deep := initialDeep; ; deep++ {
+	*searcher.completedDeep = 0
+	observer := searcher.currentObserver()
+	for deep := searcher.initialDeep; ; deep += searcher.deepStep {
+		// there should be at least one iteration
+		if deep != searcher.initialDeep &&
+			terminators.IsIterationTerminated(searcher.terminator, deep) {
+			break
+		}
+
 		searcher.searcher.SetTerminator(terminators.NewGroupTerminator(
 			searcher.terminator,
 			terminators.NewDeepTerminator(deep),
 		))
 
+		observer.IterationStarted(deep)
 		move, err := searcher.searcher.SearchMove(storage, color, 0, bounds)
 		isTerminated := searcher.terminator.IsSearchTerminated(deep)
-		if deep == initialDeep || !isTerminated {
+		if !isTerminated {
+			lastMove = moves.FailedMove{Move: move, Error: err}
+			*searcher.completedDeep = deep
+
+			terminators.CompleteIteration(searcher.terminator, deep, lastMove)
+			observer.IterationFinished(deep, lastMove)
+		} else if deep == searcher.initialDeep {
+			// a result of an aborted first iteration is only a fallback,
+			// so it isn't reported as a completed one
 			lastMove = moves.FailedMove{Move: move, Error: err}
 		}
 		// check at the loop end, because there should be at least one iteration
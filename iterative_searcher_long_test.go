@@ -7,9 +7,12 @@ import (
 	"testing"
 
 	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
 )
 
 func TestIterativeSearcher(test *testing.T) {
@@ -186,3 +189,50 @@ func TestIterativeSearcher(test *testing.T) {
 		}
 	}
 }
+
+func TestIterativeSearcher_withStabilityTerminator(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"6K1/8/7q/6p1/8/2B5/pp4PQ/k7",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	innerSearcher := NewAlphaBetaSearcher(
+		generator,
+		nil, // terminator will be set automatically by the iterative searcher
+		evaluator,
+	)
+
+	terminator := terminators.NewGroupTerminator(
+		terminators.NewDeepTerminator(10),
+		terminators.NewStabilityTerminator(3),
+	)
+	searcher := NewIterativeSearcher(innerSearcher, terminator)
+
+	gotMove, gotErr :=
+		searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+
+	wantMove := moves.ScoredMove{
+		Move: models.Move{
+			Start:  models.Position{File: 7, Rank: 1},
+			Finish: models.Position{File: 6, Rank: 0},
+		},
+		Score:   -evaluateCheckmate(1),
+		Quality: 1,
+	}
+	if !reflect.DeepEqual(gotMove, wantMove) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	// a checkmate is found on a second iteration
+	if searcher.CompletedDeep() != 2 {
+		test.Fail()
+	}
+}
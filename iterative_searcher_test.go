@@ -21,12 +21,29 @@ func TestNewIterativeSearcher(test *testing.T) {
 	if !reflect.DeepEqual(searcher.terminator, terminator) {
 		test.Fail()
 	}
+	if searcher.completedDeep == nil || *searcher.completedDeep != 0 {
+		test.Fail()
+	}
+	if searcher.initialDeep != 1 || searcher.deepStep != 1 {
+		test.Fail()
+	}
+}
+
+func TestIterativeSearcherCompletedDeep(test *testing.T) {
+	completedDeep := 23
+	searcher := IterativeSearcher{completedDeep: &completedDeep}
+
+	if got := searcher.CompletedDeep(); got != 23 {
+		test.Fail()
+	}
 }
 
 func TestIterativeSearcherSearchMove(test *testing.T) {
 	type fields struct {
-		searcher   MoveSearcher
-		terminator terminators.SearchTerminator
+		searcher    MoveSearcher
+		terminator  terminators.SearchTerminator
+		initialDeep int
+		deepStep    int
 	}
 	type args struct {
 		storage models.PieceStorage
@@ -35,11 +52,12 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 		bounds  moves.Bounds
 	}
 	type data struct {
-		fields   fields
-		args     args
-		wantDeep int
-		wantMove moves.ScoredMove
-		wantErr  bool
+		fields            fields
+		args              args
+		wantDeep          int
+		wantCompletedDeep int
+		wantMove          moves.ScoredMove
+		wantErr           bool
 	}
 
 	var testDeep int
@@ -94,6 +112,8 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 						return deep == 1
 					},
 				},
+				initialDeep: 1,
+				deepStep:    1,
 			},
 			args: args{
 				storage: MockPieceStorage{},
@@ -102,6 +122,8 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 				bounds:  moves.Bounds{Alpha: -2e6, Beta: 3e6},
 			},
 			wantDeep: 2,
+			// an aborted first iteration isn't completed
+			wantCompletedDeep: 0,
 			wantMove: moves.ScoredMove{
 				Move: models.Move{
 					Start: models.Position{
@@ -167,6 +189,8 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 						return deep == 5
 					},
 				},
+				initialDeep: 1,
+				deepStep:    1,
 			},
 			args: args{
 				storage: MockPieceStorage{},
@@ -174,7 +198,8 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 				deep:    2,
 				bounds:  moves.Bounds{Alpha: -2e6, Beta: 3e6},
 			},
-			wantDeep: 6,
+			wantDeep:          6,
+			wantCompletedDeep: 4,
 			wantMove: moves.ScoredMove{
 				Move: models.Move{
 					Start: models.Position{
@@ -190,6 +215,74 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 			},
 			wantErr: true,
 		},
+		// with a deep schedule
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					setTerminator: func(terminator terminators.SearchTerminator) {
+						if _, ok := terminator.(terminators.GroupTerminator); !ok {
+							test.Fail()
+						}
+					},
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						defer func() { testDeep++ }()
+
+						move := moves.ScoredMove{
+							Move: models.Move{
+								Start: models.Position{
+									File: testDeep + 1,
+									Rank: testDeep + 2,
+								},
+								Finish: models.Position{
+									File: testDeep + 3,
+									Rank: testDeep + 4,
+								},
+							},
+							Score: float64(testDeep + 5),
+						}
+						return move, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						if deep%2 != 0 {
+							test.Fail()
+						}
+
+						return deep >= 6
+					},
+				},
+				initialDeep: 2,
+				deepStep:    2,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+				bounds:  moves.Bounds{Alpha: -2e6, Beta: 3e6},
+			},
+			wantDeep:          4,
+			wantCompletedDeep: 4,
+			wantMove: moves.ScoredMove{
+				Move: models.Move{
+					Start: models.Position{
+						File: 3,
+						Rank: 4,
+					},
+					Finish: models.Position{
+						File: 5,
+						Rank: 6,
+					},
+				},
+				Score: float64(7),
+			},
+			wantErr: false,
+		},
 	} {
 		testDeep = 1
 
@@ -200,6 +293,12 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 			TerminatorSetter: &TerminatorSetter{
 				terminator: data.fields.terminator,
 			},
+			DeepScheduleSetter: &DeepScheduleSetter{
+				initialDeep: data.fields.initialDeep,
+				deepStep:    data.fields.deepStep,
+			},
+
+			completedDeep: new(int),
 		}
 
 		gotMove, gotErr := searcher.SearchMove(
@@ -212,6 +311,9 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 		if testDeep != data.wantDeep {
 			test.Fail()
 		}
+		if *searcher.completedDeep != data.wantCompletedDeep {
+			test.Fail()
+		}
 		if !reflect.DeepEqual(gotMove, data.wantMove) {
 			test.Fail()
 		}
@@ -220,3 +322,221 @@ func TestIterativeSearcherSearchMove(test *testing.T) {
 		}
 	}
 }
+
+func TestIterativeSearcherSearchMove_withAbortedFirstIteration(
+	test *testing.T,
+) {
+	var searchCount int
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {},
+		searchMove: func(
+			storage models.PieceStorage,
+			color models.Color,
+			deep int,
+			bounds moves.Bounds,
+		) (moves.ScoredMove, error) {
+			searchCount++
+
+			move := moves.ScoredMove{Score: float64(searchCount)}
+			return move, nil
+		},
+	}
+	terminator := MockIterationTerminator{
+		MockSearchTerminator: MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool {
+				return true
+			},
+		},
+		isIterationTerminated: func(deep int) bool {
+			panic("not implemented")
+		},
+		completeIteration: func(deep int, move moves.FailedMove) {
+			test.Fail()
+		},
+	}
+	observer := MockSearchObserver{events: new([]string)}
+	searcher := NewIterativeSearcher(innerSearcher, terminator)
+	searcher.SetDeepSchedule(5, 1)
+	searcher.SetObserver(observer)
+	*searcher.completedDeep = 3
+
+	gotMove, gotErr := searcher.SearchMove(
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	// a result of an aborted first iteration is a fallback
+	if !reflect.DeepEqual(gotMove, moves.ScoredMove{Score: 1}) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	if searcher.CompletedDeep() != 0 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(*observer.events, []string{"IterationStarted(5)"}) {
+		test.Fail()
+	}
+}
+
+type MockIterationTerminator struct {
+	MockSearchTerminator
+
+	isIterationTerminated func(deep int) bool
+	completeIteration     func(deep int, move moves.FailedMove)
+}
+
+func (terminator MockIterationTerminator) IsIterationTerminated(
+	deep int,
+) bool {
+	if terminator.isIterationTerminated == nil {
+		panic("not implemented")
+	}
+
+	return terminator.isIterationTerminated(deep)
+}
+
+func (terminator MockIterationTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	if terminator.completeIteration == nil {
+		panic("not implemented")
+	}
+
+	terminator.completeIteration(deep, move)
+}
+
+func TestIterativeSearcherSearchMove_withIterationTerminator(
+	test *testing.T,
+) {
+	var searchCount int
+	var iterationDeeps []int
+	var completedDeeps []int
+	var completedMoves []moves.FailedMove
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {},
+		searchMove: func(
+			storage models.PieceStorage,
+			color models.Color,
+			deep int,
+			bounds moves.Bounds,
+		) (moves.ScoredMove, error) {
+			searchCount++
+
+			move := moves.ScoredMove{Score: float64(searchCount)}
+			return move, nil
+		},
+	}
+	terminator := MockIterationTerminator{
+		MockSearchTerminator: MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool {
+				return false
+			},
+		},
+		isIterationTerminated: func(deep int) bool {
+			iterationDeeps = append(iterationDeeps, deep)
+			return deep == 3
+		},
+		completeIteration: func(deep int, move moves.FailedMove) {
+			completedDeeps = append(completedDeeps, deep)
+			completedMoves = append(completedMoves, move)
+		},
+	}
+	searcher := NewIterativeSearcher(innerSearcher, terminator)
+
+	gotMove, gotErr := searcher.SearchMove(
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	if !reflect.DeepEqual(gotMove, moves.ScoredMove{Score: 2}) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	if searchCount != 2 {
+		test.Fail()
+	}
+	// a first iteration is started unconditionally
+	if !reflect.DeepEqual(iterationDeeps, []int{2, 3}) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(completedDeeps, []int{1, 2}) {
+		test.Fail()
+	}
+
+	wantCompletedMoves := []moves.FailedMove{
+		{Move: moves.ScoredMove{Score: 1}},
+		{Move: moves.ScoredMove{Score: 2}},
+	}
+	if !reflect.DeepEqual(completedMoves, wantCompletedMoves) {
+		test.Fail()
+	}
+	if searcher.CompletedDeep() != 2 {
+		test.Fail()
+	}
+}
+
+func TestIterativeSearcherSetObserver(test *testing.T) {
+	observer := MockSearchObserver{events: new([]string)}
+	innerSearcher := NewAlphaBetaSearcher(
+		MockMoveGenerator{},
+		MockSearchTerminator{},
+		MockBoardEvaluator{},
+	)
+	searcher := NewIterativeSearcher(innerSearcher, MockSearchTerminator{})
+	searcher.SetObserver(observer)
+
+	if !reflect.DeepEqual(searcher.observer, observer) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(innerSearcher.observer, observer) {
+		test.Fail()
+	}
+}
+
+func TestIterativeSearcherSearchMove_withObserver(test *testing.T) {
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {},
+		searchMove: func(
+			storage models.PieceStorage,
+			color models.Color,
+			deep int,
+			bounds moves.Bounds,
+		) (moves.ScoredMove, error) {
+			return moves.ScoredMove{Score: 2.3}, nil
+		},
+	}
+	terminator := MockSearchTerminator{
+		isSearchTerminated: func(deep int) bool { return deep >= 3 },
+	}
+
+	var events []string
+	searcher := NewIterativeSearcher(innerSearcher, terminator)
+	searcher.ObserverSetter.SetObserver(MockSearchObserver{events: &events})
+	searcher.SearchMove( // nolint: errcheck
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	wantEvents := []string{
+		"IterationStarted(1)",
+		"IterationFinished(1, 2.3)",
+		"IterationStarted(2)",
+		"IterationFinished(2, 2.3)",
+		// a terminated iteration is discarded
+		"IterationStarted(3)",
+	}
+	if !reflect.DeepEqual(events, wantEvents) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,158 @@
+package chessminimax
+
+import (
+	"errors"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// ...
+var (
+	ErrMateNotFound = errors.New("mate not found")
+)
+
+// MateSolver ...
+//
+// It searches only for forced checkmates: an attacking side tries checks
+// first, and a defending side tries all replies.
+type MateSolver struct {
+	generator MoveGenerator
+}
+
+type legalMove struct {
+	move       models.Move
+	storage    models.PieceStorage
+	givesCheck bool
+}
+
+// NewMateSolver ...
+func NewMateSolver(generator MoveGenerator) MateSolver {
+	return MateSolver{generator}
+}
+
+// SolveMate ...
+//
+// It searches for a shortest forced checkmate by the passed side in no more
+// than the passed count of moves. It returns a mating line, which contains
+// moves of both sides and ends with a mating move.
+//
+// It returns only following errors:
+// * models.ErrKingCapture;
+// * ErrCheckmate (the passed side is already mated);
+// * ErrDraw (the passed side hasn't a legal move);
+// * ErrMateNotFound.
+func (solver MateSolver) SolveMate(
+	storage models.PieceStorage,
+	color models.Color,
+	maximalMoves int,
+) ([]models.Move, error) {
+	moveGroup, err := solver.legalMoves(storage, color)
+	if err != nil {
+		return nil, err
+	}
+	if len(moveGroup) == 0 {
+		if isKingUnderAttack(solver.generator, storage, color) {
+			return nil, ErrCheckmate
+		}
+
+		return nil, ErrDraw
+	}
+
+	for movesLeft := 1; movesLeft <= maximalMoves; movesLeft++ {
+		if line, ok := solver.attack(storage, color, movesLeft); ok {
+			return line, nil
+		}
+	}
+
+	return nil, ErrMateNotFound
+}
+
+func (solver MateSolver) attack(
+	storage models.PieceStorage,
+	color models.Color,
+	movesLeft int,
+) (line []models.Move, ok bool) {
+	moveGroup, err := solver.legalMoves(storage, color)
+	if err != nil {
+		return nil, false
+	}
+
+	// checks go first, because they are more likely to lead to a checkmate
+	for _, checkRequired := range []bool{true, false} {
+		// only a check can be a mating move
+		if !checkRequired && movesLeft == 1 {
+			break
+		}
+
+		for _, move := range moveGroup {
+			if move.givesCheck != checkRequired {
+				continue
+			}
+
+			nextColor := color.Negative()
+			nextLine, ok := solver.defend(move.storage, nextColor, movesLeft)
+			if ok {
+				return append([]models.Move{move.move}, nextLine...), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (solver MateSolver) defend(
+	storage models.PieceStorage,
+	color models.Color,
+	movesLeft int,
+) (line []models.Move, ok bool) {
+	moveGroup, err := solver.legalMoves(storage, color)
+	if err != nil {
+		return nil, false
+	}
+	if len(moveGroup) == 0 {
+		// only a checkmate is a success, a stalemate isn't
+		isCheckmate := isKingUnderAttack(solver.generator, storage, color)
+		return nil, isCheckmate
+	}
+	if movesLeft == 1 {
+		return nil, false
+	}
+
+	// a defending side chooses a longest resistance
+	var longestLine []models.Move
+	for _, move := range moveGroup {
+		nextColor := color.Negative()
+		nextLine, ok := solver.attack(move.storage, nextColor, movesLeft-1)
+		if !ok {
+			return nil, false
+		}
+
+		nextLine = append([]models.Move{move.move}, nextLine...)
+		if len(nextLine) > len(longestLine) {
+			longestLine = nextLine
+		}
+	}
+
+	return longestLine, true
+}
+
+func (solver MateSolver) legalMoves(
+	storage models.PieceStorage,
+	color models.Color,
+) ([]legalMove, error) {
+	moveGroup, err := moves.LegalMoves(solver.generator, storage, color)
+	if err != nil {
+		return nil, err
+	}
+
+	var legalMoves []legalMove
+	for _, move := range moveGroup {
+		nextStorage := storage.ApplyMove(move)
+		nextColor := color.Negative()
+		givesCheck := isKingUnderAttack(solver.generator, nextStorage, nextColor)
+		legalMoves = append(legalMoves, legalMove{move, nextStorage, givesCheck})
+	}
+
+	return legalMoves, nil
+}
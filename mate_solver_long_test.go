@@ -0,0 +1,125 @@
+// +build long
+
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestMateSolver(test *testing.T) {
+	type args struct {
+		boardInFEN   string
+		color        models.Color
+		maximalMoves int
+	}
+	type data struct {
+		args           args
+		wantLineLength int
+		wantLineStart  []models.Move
+		wantErr        error
+	}
+
+	for _, data := range []data{
+		// king capture
+		{
+			args: args{
+				boardInFEN:   "7K/8/8/8/8/8/8/k6R",
+				color:        models.White,
+				maximalMoves: 1,
+			},
+			wantLineLength: 0,
+			wantErr:        models.ErrKingCapture,
+		},
+		// already checkmated
+		{
+			args: args{
+				boardInFEN:   "6BK/8/8/8/8/pp6/k6R/7R",
+				color:        models.Black,
+				maximalMoves: 1,
+			},
+			wantLineLength: 0,
+			wantErr:        ErrCheckmate,
+		},
+		// mate in one
+		{
+			args: args{
+				boardInFEN:   "7K/7R/8/8/8/8/6R1/k7",
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLineLength: 1,
+			wantLineStart: []models.Move{
+				{
+					Start:  models.Position{File: 7, Rank: 6},
+					Finish: models.Position{File: 7, Rank: 0},
+				},
+			},
+			wantErr: nil,
+		},
+		// mate in two
+		{
+			args: args{
+				boardInFEN:   "7K/8/8/8/7R/6R1/8/k7",
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLineLength: 3,
+			wantErr:        nil,
+		},
+		// mate in two isn't found within one move
+		{
+			args: args{
+				boardInFEN:   "7K/8/8/8/7R/6R1/8/k7",
+				color:        models.White,
+				maximalMoves: 1,
+			},
+			wantLineLength: 0,
+			wantErr:        ErrMateNotFound,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fail()
+			continue
+		}
+
+		var generator models.MoveGenerator
+		solver := NewMateSolver(generator)
+		gotLine, gotErr :=
+			solver.SolveMate(storage, data.args.color, data.args.maximalMoves)
+
+		if len(gotLine) != data.wantLineLength {
+			test.Fail()
+		}
+		if data.wantLineStart != nil &&
+			!reflect.DeepEqual(gotLine[:len(data.wantLineStart)], data.wantLineStart) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+
+		// a mating line should lead to a checkmate
+		if gotErr == nil {
+			color := data.args.color
+			for _, move := range gotLine {
+				storage = storage.ApplyMove(move)
+				color = color.Negative()
+			}
+
+			_, err := solver.SolveMate(storage, color, 1)
+			if err != ErrCheckmate {
+				test.Fail()
+			}
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewMateSolver(test *testing.T) {
+	var generator MockMoveGenerator
+	solver := NewMateSolver(generator)
+
+	if !reflect.DeepEqual(solver.generator, generator) {
+		test.Fail()
+	}
+}
+
+func TestMateSolverSolveMate(test *testing.T) {
+	type fields struct {
+		generator MoveGenerator
+	}
+	type args struct {
+		storage      models.PieceStorage
+		color        models.Color
+		maximalMoves int
+	}
+	type data struct {
+		fields   fields
+		args     args
+		wantLine []models.Move
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+
+						return nil, models.ErrKingCapture
+					},
+				},
+			},
+			args: args{
+				storage:      MockPieceStorage{},
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLine: nil,
+			wantErr:  models.ErrKingCapture,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+
+						// black color means a call for checking, if a king
+						// is under an attack
+						if color == models.Black {
+							return nil, models.ErrKingCapture
+						}
+
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				storage:      MockPieceStorage{},
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLine: nil,
+			wantErr:  ErrCheckmate,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				storage:      MockPieceStorage{},
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLine: nil,
+			wantErr:  ErrDraw,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						mock, ok := storage.(MockPieceStorage)
+						if !ok {
+							test.Fail()
+						}
+
+						// an initial position
+						if mock.appliedMove.IsZero() {
+							if color != models.White {
+								return nil, nil
+							}
+
+							moves := []models.Move{
+								{
+									Start:  models.Position{File: 1, Rank: 2},
+									Finish: models.Position{File: 3, Rank: 4},
+								},
+								{
+									Start:  models.Position{File: 5, Rank: 6},
+									Finish: models.Position{File: 7, Rank: 8},
+								},
+							}
+							return moves, nil
+						}
+
+						// a position after a mating move
+						if mock.appliedMove.Start.File == 5 {
+							// white color means a call for checking, if a black king
+							// is under an attack
+							if color == models.White {
+								return nil, models.ErrKingCapture
+							}
+
+							return nil, nil
+						}
+
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{
+					applyMove: func(move models.Move) models.PieceStorage {
+						return MockPieceStorage{
+							appliedMove: move,
+						}
+					},
+				},
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLine: []models.Move{
+				{
+					Start:  models.Position{File: 5, Rank: 6},
+					Finish: models.Position{File: 7, Rank: 8},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						mock, ok := storage.(MockPieceStorage)
+						if !ok {
+							test.Fail()
+						}
+
+						// an initial position
+						if mock.appliedMove.IsZero() && color == models.White {
+							moves := []models.Move{
+								{
+									Start:  models.Position{File: 1, Rank: 2},
+									Finish: models.Position{File: 3, Rank: 4},
+								},
+							}
+							return moves, nil
+						}
+
+						// a stalemate after a move
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{
+					applyMove: func(move models.Move) models.PieceStorage {
+						return MockPieceStorage{
+							appliedMove: move,
+						}
+					},
+				},
+				color:        models.White,
+				maximalMoves: 2,
+			},
+			wantLine: nil,
+			wantErr:  ErrMateNotFound,
+		},
+	} {
+		solver := MateSolver{
+			generator: data.fields.generator,
+		}
+		gotLine, gotErr := solver.SolveMate(
+			data.args.storage,
+			data.args.color,
+			data.args.maximalMoves,
+		)
+
+		if !reflect.DeepEqual(gotLine, data.wantLine) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
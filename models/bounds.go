@@ -6,6 +6,18 @@ import (
 	models "github.com/thewizardplusplus/go-chess-models"
 )
 
+// BoundKind ...
+//
+// It's a kind of a score relative to bounds, with which it was obtained.
+type BoundKind int
+
+// ...
+const (
+	ExactBound BoundKind = iota
+	LowerBound
+	UpperBound
+)
+
 // Bounds ...
 type Bounds struct {
 	Alpha float64
@@ -44,3 +56,32 @@ func (bounds *Bounds) Update(
 
 	return scoredMove, true
 }
+
+// ScoreBound ...
+//
+// It returns a kind of a score obtained with these bounds.
+func (bounds Bounds) ScoreBound(score float64) BoundKind {
+	switch {
+	case score <= bounds.Alpha:
+		return UpperBound
+	case score >= bounds.Beta:
+		return LowerBound
+	}
+
+	return ExactBound
+}
+
+// IsCompatible ...
+//
+// It checks, if a score of the specified kind obtained with other bounds
+// can be used with these ones.
+func (bounds Bounds) IsCompatible(score float64, kind BoundKind) bool {
+	switch kind {
+	case LowerBound:
+		return score >= bounds.Beta
+	case UpperBound:
+		return score <= bounds.Alpha
+	}
+
+	return true
+}
@@ -248,3 +248,43 @@ func TestBoundsUpdate(test *testing.T) {
 		}
 	}
 }
+
+func TestBoundsScoreBound(test *testing.T) {
+	bounds := Bounds{-2.3, 4.2}
+	for score, want := range map[float64]BoundKind{
+		-5:   UpperBound,
+		-2.3: UpperBound,
+		0:    ExactBound,
+		4.2:  LowerBound,
+		5:    LowerBound,
+	} {
+		if got := bounds.ScoreBound(score); got != want {
+			test.Fail()
+		}
+	}
+}
+
+func TestBoundsIsCompatible(test *testing.T) {
+	type args struct {
+		score float64
+		kind  BoundKind
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	bounds := Bounds{-2.3, 4.2}
+	for _, data := range []data{
+		{args{0, ExactBound}, true},
+		{args{5, LowerBound}, true},
+		{args{0, LowerBound}, false},
+		{args{-5, UpperBound}, true},
+		{args{0, UpperBound}, false},
+	} {
+		got := bounds.IsCompatible(data.args.score, data.args.kind)
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -4,4 +4,5 @@ package models
 type FailedMove struct {
 	Move  ScoredMove
 	Error error
+	Bound BoundKind
 }
@@ -0,0 +1,6 @@
+package models
+
+// InitialBoardInFEN ...
+//
+// It's a board of the initial position of a game in Forsyth–Edwards Notation.
+const InitialBoardInFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR"
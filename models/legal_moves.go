@@ -0,0 +1,66 @@
+package models
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// MoveGenerator ...
+type MoveGenerator interface {
+	MovesForColor(
+		storage models.PieceStorage,
+		color models.Color,
+	) ([]models.Move, error)
+}
+
+// LegalMoves ...
+//
+// It generates moves, which don't leave an own king under an attack.
+// If an opponent king is under an attack, it returns an error
+// of the generator (i.e. models.ErrKingCapture).
+func LegalMoves(
+	generator MoveGenerator,
+	storage models.PieceStorage,
+	color models.Color,
+) ([]models.Move, error) {
+	moveGroup, err := generator.MovesForColor(storage, color)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterLegalMoves(generator, storage, color, moveGroup), nil
+}
+
+// FilterLegalMoves ...
+//
+// It passes only moves, which don't leave an own king under an attack,
+// keeping their original order.
+func FilterLegalMoves(
+	generator MoveGenerator,
+	storage models.PieceStorage,
+	color models.Color,
+	moveGroup []models.Move,
+) []models.Move {
+	var legalMoves []models.Move
+	for _, move := range moveGroup {
+		if IsLegalMove(generator, storage, color, move) {
+			legalMoves = append(legalMoves, move)
+		}
+	}
+
+	return legalMoves
+}
+
+// IsLegalMove ...
+//
+// It checks, that a move doesn't leave an own king under an attack.
+// The move should be generated by the generator.
+func IsLegalMove(
+	generator MoveGenerator,
+	storage models.PieceStorage,
+	color models.Color,
+	move models.Move,
+) bool {
+	nextStorage := storage.ApplyMove(move)
+	_, err := generator.MovesForColor(nextStorage, color.Negative())
+	return err == nil
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestLegalMoves(test *testing.T) {
+	type data struct {
+		boardInFEN string
+		color      models.Color
+		wantMoves  []models.Move
+		wantErr    error
+	}
+
+	for _, data := range []data{
+		// a pinned bishop and a king
+		{
+			boardInFEN: "4r3/8/8/8/8/8/4B3/k3K3",
+			color:      models.White,
+			wantMoves: []models.Move{
+				{
+					Start:  models.Position{File: 4, Rank: 0},
+					Finish: models.Position{File: 3, Rank: 0},
+				},
+				{
+					Start:  models.Position{File: 4, Rank: 0},
+					Finish: models.Position{File: 3, Rank: 1},
+				},
+				{
+					Start:  models.Position{File: 4, Rank: 0},
+					Finish: models.Position{File: 5, Rank: 0},
+				},
+				{
+					Start:  models.Position{File: 4, Rank: 0},
+					Finish: models.Position{File: 5, Rank: 1},
+				},
+			},
+			wantErr: nil,
+		},
+		// a stalemate
+		{
+			boardInFEN: "k7/2Q5/1K6/8/8/8/8/8",
+			color:      models.Black,
+			wantMoves:  nil,
+			wantErr:    nil,
+		},
+		{
+			boardInFEN: "k7/8/8/8/8/8/8/R3K3",
+			color:      models.White,
+			wantMoves:  nil,
+			wantErr:    models.ErrKingCapture,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.boardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var generator models.MoveGenerator
+		gotMoves, gotErr := LegalMoves(generator, storage, data.color)
+
+		if !sameMoves(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsLegalMove(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"4r3/8/8/8/8/8/4B3/k3K3",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var generator models.MoveGenerator
+	pinnedMove := models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 3, Rank: 2},
+	}
+	if IsLegalMove(generator, storage, models.White, pinnedMove) {
+		test.Fail()
+	}
+
+	kingMove := models.Move{
+		Start:  models.Position{File: 4, Rank: 0},
+		Finish: models.Position{File: 3, Rank: 0},
+	}
+	if !IsLegalMove(generator, storage, models.White, kingMove) {
+		test.Fail()
+	}
+
+	got := FilterLegalMoves(
+		generator,
+		storage,
+		models.White,
+		[]models.Move{pinnedMove, kingMove},
+	)
+	if !reflect.DeepEqual(got, []models.Move{kingMove}) {
+		test.Fail()
+	}
+}
+
+// an order of moves depends on the generator, so it's ignored
+func sameMoves(moves []models.Move, otherMoves []models.Move) bool {
+	if len(moves) != len(otherMoves) {
+		return false
+	}
+
+	counts := make(map[models.Move]int)
+	for _, move := range moves {
+		counts[move]++
+	}
+	for _, move := range otherMoves {
+		counts[move]--
+	}
+	for _, count := range counts {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
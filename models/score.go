@@ -0,0 +1,132 @@
+package models
+
+import (
+	"math"
+)
+
+// ScoreKind ...
+type ScoreKind int
+
+// ...
+const (
+	EvaluationScore ScoreKind = iota
+	MateScore
+)
+
+// CheckmateScore ...
+//
+// It's an absolute value of a score of a checkmate on a root of a search.
+// A score of a checkmate found deeper is closer to zero by a count of plies
+// to it, so shorter checkmates are preferred.
+const CheckmateScore = 1e6
+
+// it's a maximal count of plies to a checkmate, which is distinguishable
+// from an evaluation score
+const maximalMatePlies = 1e3
+
+// Score ...
+//
+// It distinguishes evaluation scores from checkmates. A mate is a count
+// of moves (not plies) to a checkmate; it's positive, if a current side
+// mates, and negative or zero, if it's mated.
+type Score struct {
+	Kind  ScoreKind
+	Value float64
+	Mate  int
+}
+
+// NewEvaluationScore ...
+func NewEvaluationScore(value float64) Score {
+	return Score{Kind: EvaluationScore, Value: value}
+}
+
+// NewMateScore ...
+func NewMateScore(mate int) Score {
+	return Score{Kind: MateScore, Mate: mate}
+}
+
+// NewCheckmateScore ...
+//
+// It returns a raw score of a checkmate for a current side, so its result
+// is negative.
+func NewCheckmateScore(deep int) float64 {
+	return -(CheckmateScore - float64(deep))
+}
+
+// ParseScore ...
+//
+// It converts a raw score relative to a root of a search.
+func ParseScore(rawScore float64) Score {
+	if !IsMateScore(rawScore) {
+		return NewEvaluationScore(rawScore)
+	}
+
+	plies := int(CheckmateScore - math.Abs(rawScore))
+	if rawScore > 0 {
+		return NewMateScore((plies + 1) / 2)
+	}
+
+	return NewMateScore(-plies / 2)
+}
+
+// IsMateScore ...
+func IsMateScore(rawScore float64) bool {
+	return math.Abs(rawScore) > CheckmateScore-maximalMatePlies
+}
+
+// RawScore ...
+//
+// It returns a raw score relative to a root of a search.
+func (score Score) RawScore() float64 {
+	if score.Kind != MateScore {
+		return score.Value
+	}
+
+	if score.Mate > 0 {
+		plies := 2*score.Mate - 1
+		return -NewCheckmateScore(plies)
+	}
+
+	plies := -2 * score.Mate
+	return NewCheckmateScore(plies)
+}
+
+// Centipawns ...
+//
+// It supposes that a value of an evaluation score is measured in pawns.
+// It returns zero for a mate score.
+func (score Score) Centipawns() int {
+	if score.Kind != EvaluationScore {
+		return 0
+	}
+
+	return int(math.Round(score.Value * 100))
+}
+
+// ScoreToNode ...
+//
+// It converts a mate score relative to a root of a search to a score
+// relative to a node with the passed deep (e.g. for storing in a cache).
+// Evaluation scores are returned as is.
+func ScoreToNode(rawScore float64, deep int) float64 {
+	return shiftMateScore(rawScore, deep)
+}
+
+// ScoreFromNode ...
+//
+// It's the inverse of ScoreToNode.
+func ScoreFromNode(rawScore float64, deep int) float64 {
+	return shiftMateScore(rawScore, -deep)
+}
+
+func shiftMateScore(rawScore float64, plies int) float64 {
+	if !IsMateScore(rawScore) {
+		return rawScore
+	}
+
+	if rawScore > 0 {
+		return rawScore + float64(plies)
+	}
+
+	return rawScore - float64(plies)
+}
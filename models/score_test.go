@@ -0,0 +1,266 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewEvaluationScore(test *testing.T) {
+	got := NewEvaluationScore(2.3)
+
+	want := Score{Kind: EvaluationScore, Value: 2.3}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestNewMateScore(test *testing.T) {
+	got := NewMateScore(-2)
+
+	want := Score{Kind: MateScore, Mate: -2}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestNewCheckmateScore(test *testing.T) {
+	scoreOne := NewCheckmateScore(2)
+	scoreTwo := NewCheckmateScore(3)
+
+	if scoreOne >= 0 {
+		test.Fail()
+	}
+	if scoreTwo >= 0 {
+		test.Fail()
+	}
+	// a later checkmate is better for a mated side
+	if scoreTwo <= scoreOne {
+		test.Fail()
+	}
+}
+
+func TestParseScore(test *testing.T) {
+	type args struct {
+		rawScore float64
+	}
+	type data struct {
+		args args
+		want Score
+	}
+
+	for _, data := range []data{
+		{
+			args: args{2.3},
+			want: Score{Kind: EvaluationScore, Value: 2.3},
+		},
+		{
+			args: args{-2.3},
+			want: Score{Kind: EvaluationScore, Value: -2.3},
+		},
+		{
+			args: args{-NewCheckmateScore(1)},
+			want: Score{Kind: MateScore, Mate: 1},
+		},
+		{
+			args: args{-NewCheckmateScore(5)},
+			want: Score{Kind: MateScore, Mate: 3},
+		},
+		{
+			args: args{NewCheckmateScore(0)},
+			want: Score{Kind: MateScore, Mate: 0},
+		},
+		{
+			args: args{NewCheckmateScore(4)},
+			want: Score{Kind: MateScore, Mate: -2},
+		},
+	} {
+		got := ParseScore(data.args.rawScore)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsMateScore(test *testing.T) {
+	type args struct {
+		rawScore float64
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{2.3},
+			want: false,
+		},
+		{
+			args: args{-200},
+			want: false,
+		},
+		{
+			args: args{NewCheckmateScore(3)},
+			want: true,
+		},
+		{
+			args: args{-NewCheckmateScore(3)},
+			want: true,
+		},
+	} {
+		got := IsMateScore(data.args.rawScore)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestScoreRawScore(test *testing.T) {
+	type fields struct {
+		kind  ScoreKind
+		value float64
+		mate  int
+	}
+	type data struct {
+		fields fields
+		want   float64
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{kind: EvaluationScore, value: 2.3},
+			want:   2.3,
+		},
+		{
+			fields: fields{kind: MateScore, mate: 1},
+			want:   -NewCheckmateScore(1),
+		},
+		{
+			fields: fields{kind: MateScore, mate: 3},
+			want:   -NewCheckmateScore(5),
+		},
+		{
+			fields: fields{kind: MateScore, mate: 0},
+			want:   NewCheckmateScore(0),
+		},
+		{
+			fields: fields{kind: MateScore, mate: -2},
+			want:   NewCheckmateScore(4),
+		},
+	} {
+		score := Score{
+			Kind:  data.fields.kind,
+			Value: data.fields.value,
+			Mate:  data.fields.mate,
+		}
+		got := score.RawScore()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestScoreCentipawns(test *testing.T) {
+	type fields struct {
+		kind  ScoreKind
+		value float64
+		mate  int
+	}
+	type data struct {
+		fields fields
+		want   int
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{kind: EvaluationScore, value: 2.3},
+			want:   230,
+		},
+		{
+			fields: fields{kind: EvaluationScore, value: -0.125},
+			want:   -13,
+		},
+		{
+			fields: fields{kind: MateScore, mate: 2},
+			want:   0,
+		},
+	} {
+		score := Score{
+			Kind:  data.fields.kind,
+			Value: data.fields.value,
+			Mate:  data.fields.mate,
+		}
+		got := score.Centipawns()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestScoreToNode(test *testing.T) {
+	type args struct {
+		rawScore float64
+		deep     int
+	}
+	type data struct {
+		args args
+		want float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{rawScore: 2.3, deep: 2},
+			want: 2.3,
+		},
+		{
+			args: args{rawScore: -NewCheckmateScore(5), deep: 2},
+			want: -NewCheckmateScore(3),
+		},
+		{
+			args: args{rawScore: NewCheckmateScore(4), deep: 2},
+			want: NewCheckmateScore(2),
+		},
+	} {
+		got := ScoreToNode(data.args.rawScore, data.args.deep)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestScoreFromNode(test *testing.T) {
+	type args struct {
+		rawScore float64
+		deep     int
+	}
+	type data struct {
+		args args
+		want float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{rawScore: 2.3, deep: 2},
+			want: 2.3,
+		},
+		{
+			args: args{rawScore: -NewCheckmateScore(3), deep: 2},
+			want: -NewCheckmateScore(5),
+		},
+		{
+			args: args{rawScore: NewCheckmateScore(2), deep: 2},
+			want: NewCheckmateScore(4),
+		},
+	} {
+		got := ScoreFromNode(data.args.rawScore, data.args.deep)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package chessminimax
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// MonteCarloSearcher ...
+//
+// It implements a Monte Carlo tree search with the UCT selection.
+// A playout is a sequence of random moves of the specified length,
+// which is evaluated by the evaluator at its end.
+//
+// It passes a count of performed iterations as a deep to the terminator,
+// so DeepTerminator limits that count. Every iteration adds a node to a tree,
+// so it's counted as a visited node.
+//
+// It isn't safe for concurrent use, so ParallelSearcher should create
+// a separate instance for every worker. Trees of workers are independent
+// and their statistics aren't merged, so only a result selector
+// of ParallelSearcher combines them, e.g. SelectVotedResult().
+//
+// A move leading to a checkmate is always selected. A score of a move leading
+// to an end of a game is exact; otherwise, it's estimated by a win
+// probability of the move.
+type MonteCarloSearcher struct {
+	*TerminatorSetter
+
+	generator        MoveGenerator
+	evaluator        evaluators.BoardEvaluator
+	randomizer       *rand.Rand
+	playoutLength    int
+	explorationRatio float64
+	evaluationScale  float64
+}
+
+// MonteCarloOptions ...
+type MonteCarloOptions struct {
+	Seed          int64
+	PlayoutLength int
+
+	// it's a constant of the UCT formula; if it's zero,
+	// the square root of two is used
+	ExplorationRatio float64
+
+	// it's a score, which corresponds to a win probability of about 73%;
+	// if it's zero, one is used
+	EvaluationScale float64
+}
+
+const (
+	defaultExplorationRatio = math.Sqrt2
+	defaultEvaluationScale  = 1
+	maximalWinProbability   = 1 - 1e-6
+)
+
+type monteCarloNode struct {
+	storage  models.PieceStorage
+	color    models.Color
+	move     models.Move
+	parent   *monteCarloNode
+	children []*monteCarloNode
+
+	untriedMoves []models.Move
+	isTerminal   bool
+	terminalErr  error
+
+	visits int
+	// it's a sum of results from the point of view of a side,
+	// which made a move leading to this node
+	wins float64
+}
+
+// NewMonteCarloSearcher ...
+func NewMonteCarloSearcher(
+	generator MoveGenerator,
+	terminator terminators.SearchTerminator,
+	evaluator evaluators.BoardEvaluator,
+	options MonteCarloOptions,
+) MonteCarloSearcher {
+	explorationRatio := options.ExplorationRatio
+	if explorationRatio == 0 {
+		explorationRatio = defaultExplorationRatio
+	}
+
+	evaluationScale := options.EvaluationScale
+	if evaluationScale == 0 {
+		evaluationScale = defaultEvaluationScale
+	}
+
+	searcher := MonteCarloSearcher{
+		TerminatorSetter: new(TerminatorSetter),
+
+		generator:        generator,
+		evaluator:        evaluator,
+		randomizer:       rand.New(rand.NewSource(options.Seed)),
+		playoutLength:    options.PlayoutLength,
+		explorationRatio: explorationRatio,
+		evaluationScale:  evaluationScale,
+	}
+
+	searcher.SetTerminator(terminator)
+
+	return searcher
+}
+
+// SetSearcher ...
+//
+// It does nothing and is required only for correspondence
+// to the MoveSearcher interface.
+//
+// It always panics.
+func (MonteCarloSearcher) SetSearcher(innerSearcher MoveSearcher) {
+	panic("not supported")
+}
+
+// SearchMove ...
+//
+// It ignores the bounds.
+func (searcher MonteCarloSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	root := &monteCarloNode{storage: storage, color: color}
+	if err := searcher.expand(root); err != nil {
+		return moves.ScoredMove{}, err
+	}
+	if root.isTerminal {
+		var score float64
+		if root.terminalErr == ErrCheckmate {
+			score = evaluateCheckmate(deep)
+		}
+
+		return moves.ScoredMove{Score: score}, root.terminalErr
+	}
+
+	// check at the loop end, because there should be at least one iteration
+	for iteration := 0; ; iteration++ {
+		node := searcher.selectNode(root)
+		result := searcher.simulate(node)
+		node.backpropagate(result)
+
+		terminators.CountNode(searcher.terminator, iteration+1)
+		if searcher.terminator.IsSearchTerminated(iteration + 1) {
+			break
+		}
+	}
+
+	bestNode := root.matingChild()
+	if bestNode == nil {
+		bestNode = root.mostVisitedChild()
+	}
+
+	moveQuality := evaluateQuality(searcher, deep)
+	return moves.ScoredMove{
+		Move:    bestNode.move,
+		Score:   searcher.evaluateNode(bestNode, deep+1),
+		Quality: moveQuality,
+	}, nil
+}
+
+// it returns a score from the point of view of a side,
+// which made a move leading to the node
+func (searcher MonteCarloSearcher) evaluateNode(
+	node *monteCarloNode,
+	deep int,
+) float64 {
+	if node.isTerminal {
+		if node.terminalErr == ErrCheckmate {
+			return -evaluateCheckmate(deep)
+		}
+
+		// score of a draw is a null
+		return 0
+	}
+
+	return searcher.winProbabilityToScore(node.wins / float64(node.visits))
+}
+
+func (searcher MonteCarloSearcher) selectNode(
+	node *monteCarloNode,
+) *monteCarloNode {
+	for {
+		if node.isTerminal {
+			return node
+		}
+		if len(node.untriedMoves) != 0 {
+			return searcher.addChild(node)
+		}
+
+		node = node.bestChild(searcher.explorationRatio)
+	}
+}
+
+// it returns an error only if a side to move can capture an enemy king
+func (searcher MonteCarloSearcher) expand(node *monteCarloNode) error {
+	moveGroup, err :=
+		moves.LegalMoves(searcher.generator, node.storage, node.color)
+	if err != nil {
+		return err
+	}
+
+	node.untriedMoves = moveGroup
+	if len(node.untriedMoves) == 0 {
+		node.isTerminal = true
+		node.terminalErr = ErrDraw
+		if isKingUnderAttack(searcher.generator, node.storage, node.color) {
+			node.terminalErr = ErrCheckmate
+		}
+	}
+
+	return nil
+}
+
+func (searcher MonteCarloSearcher) addChild(
+	node *monteCarloNode,
+) *monteCarloNode {
+	index := searcher.randomizer.Intn(len(node.untriedMoves))
+	move := node.untriedMoves[index]
+
+	lastIndex := len(node.untriedMoves) - 1
+	node.untriedMoves[index] = node.untriedMoves[lastIndex]
+	node.untriedMoves = node.untriedMoves[:lastIndex]
+
+	child := &monteCarloNode{
+		storage: node.storage.ApplyMove(move),
+		color:   node.color.Negative(),
+		move:    move,
+		parent:  node,
+	}
+	node.children = append(node.children, child)
+
+	return child
+}
+
+// it returns a result from the point of view of a side,
+// which made a move leading to the node
+func (searcher MonteCarloSearcher) simulate(node *monteCarloNode) float64 {
+	if node.visits == 0 {
+		// there should be no error, because illegal moves are filtered
+		// on an expanding of a parent
+		searcher.expand(node) // nolint: errcheck
+	}
+	if node.isTerminal {
+		return terminalResult(node.terminalErr)
+	}
+
+	storage, color := node.storage, node.color
+	sign := 1.0
+	for i := 0; i < searcher.playoutLength; i++ {
+		moveGroup, err := moves.LegalMoves(searcher.generator, storage, color)
+		if err != nil {
+			break
+		}
+
+		if len(moveGroup) == 0 {
+			terminalErr := ErrDraw
+			if isKingUnderAttack(searcher.generator, storage, color) {
+				terminalErr = ErrCheckmate
+			}
+
+			result := terminalResult(terminalErr)
+			if sign < 0 {
+				result = 1 - result
+			}
+
+			return result
+		}
+
+		move := moveGroup[searcher.randomizer.Intn(len(moveGroup))]
+		storage = storage.ApplyMove(move)
+		color = color.Negative()
+		sign = -sign
+	}
+
+	// a score is evaluated for a side to move, so it should be negated
+	score := -sign * searcher.evaluator.EvaluateBoard(storage, color)
+	return searcher.scoreToWinProbability(score)
+}
+
+func (searcher MonteCarloSearcher) scoreToWinProbability(
+	score float64,
+) float64 {
+	return 1 / (1 + math.Exp(-score/searcher.evaluationScale))
+}
+
+func (searcher MonteCarloSearcher) winProbabilityToScore(
+	probability float64,
+) float64 {
+	probability = math.Max(probability, 1-maximalWinProbability)
+	probability = math.Min(probability, maximalWinProbability)
+	return searcher.evaluationScale * math.Log(probability/(1-probability))
+}
+
+// it returns a result from the point of view of a side,
+// which made a move leading to a terminal position
+func terminalResult(err error) float64 {
+	if err == ErrCheckmate {
+		return 1
+	}
+
+	return 0.5
+}
+
+func (node *monteCarloNode) backpropagate(result float64) {
+	for ; node != nil; node = node.parent {
+		node.visits++
+		node.wins += result
+
+		// a result for a previous side is opposite
+		result = 1 - result
+	}
+}
+
+func (node *monteCarloNode) bestChild(
+	explorationRatio float64,
+) *monteCarloNode {
+	var bestChild *monteCarloNode
+	bestValue := math.Inf(-1)
+	logarithm := math.Log(float64(node.visits))
+	for _, child := range node.children {
+		visits := float64(child.visits)
+		exploitation := child.wins / visits
+		exploration := explorationRatio * math.Sqrt(logarithm/visits)
+		if value := exploitation + exploration; value > bestValue {
+			bestChild, bestValue = child, value
+		}
+	}
+
+	return bestChild
+}
+
+// it returns nil, if there isn't a child with a checkmate
+func (node *monteCarloNode) matingChild() *monteCarloNode {
+	for _, child := range node.children {
+		if child.isTerminal && child.terminalErr == ErrCheckmate {
+			return child
+		}
+	}
+
+	return nil
+}
+
+func (node *monteCarloNode) mostVisitedChild() *monteCarloNode {
+	var bestChild *monteCarloNode
+	for _, child := range node.children {
+		if bestChild == nil || child.visits > bestChild.visits {
+			bestChild = child
+		}
+	}
+
+	return bestChild
+}
@@ -0,0 +1,156 @@
+// +build long
+
+package chessminimax
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestMonteCarloSearcher(test *testing.T) {
+	type args struct {
+		boardInFEN        string
+		color             models.Color
+		maximalIterations int
+	}
+	type data struct {
+		args     args
+		wantMove models.Move
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		// king capture
+		{
+			args: args{
+				boardInFEN:        "7K/8/8/8/8/8/8/k6R",
+				color:             models.White,
+				maximalIterations: 100,
+			},
+			wantMove: models.Move{},
+			wantErr:  models.ErrKingCapture,
+		},
+		// checkmate
+		{
+			args: args{
+				boardInFEN:        "6BK/8/8/8/8/pp6/k6R/7R",
+				color:             models.Black,
+				maximalIterations: 100,
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrCheckmate,
+		},
+		// single legal move
+		{
+			args: args{
+				boardInFEN:        "7K/8/7q/8/8/8/8/k7",
+				color:             models.White,
+				maximalIterations: 100,
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 7, Rank: 7},
+				Finish: models.Position{File: 6, Rank: 7},
+			},
+			wantErr: nil,
+		},
+		// single profitable move
+		{
+			args: args{
+				boardInFEN:        "7K/8/7q/8/8/8/7Q/k7",
+				color:             models.White,
+				maximalIterations: 1000,
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 7, Rank: 1},
+				Finish: models.Position{File: 7, Rank: 5},
+			},
+			wantErr: nil,
+		},
+	} {
+		gotMove, gotErr := monteCarloSearch(
+			data.args.boardInFEN,
+			data.args.color,
+			data.args.maximalIterations,
+		)
+
+		if gotMove.Move != data.wantMove {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestMonteCarloSearcherWithParallelSearcher(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"7K/8/7q/8/8/8/7Q/k7",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	terminator := terminators.NewDeepTerminator(1000)
+	searcher := NewParallelSearcher(terminator, 4, func(index int) MoveSearcher {
+		var generator models.MoveGenerator
+		var evaluator evaluators.MaterialEvaluator
+
+		return NewMonteCarloSearcher(
+			generator,
+			nil, // terminator will be set automatically by the parallel searcher
+			evaluator,
+			MonteCarloOptions{Seed: int64(index) + 1},
+		)
+	}, SelectFirstResult)
+
+	gotMove, gotErr :=
+		searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+
+	wantMove := models.Move{
+		Start:  models.Position{File: 7, Rank: 1},
+		Finish: models.Position{File: 7, Rank: 5},
+	}
+	if gotMove.Move != wantMove {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+func monteCarloSearch(
+	boardInFEN string,
+	color models.Color,
+	maximalIterations int,
+) (moves.ScoredMove, error) {
+	storage, err :=
+		uci.DecodePieceStorage(boardInFEN, pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		return moves.ScoredMove{}, err
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	terminator := terminators.NewDeepTerminator(maximalIterations)
+	searcher := NewMonteCarloSearcher(
+		generator,
+		terminator,
+		evaluator,
+		MonteCarloOptions{Seed: 1},
+	)
+
+	return searcher.SearchMove(
+		storage,
+		color,
+		0, // initial deep
+		moves.NewBounds(),
+	)
+}
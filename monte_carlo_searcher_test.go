@@ -0,0 +1,447 @@
+package chessminimax
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewMonteCarloSearcher(test *testing.T) {
+	type args struct {
+		options MonteCarloOptions
+	}
+	type data struct {
+		args                 args
+		wantPlayoutLength    int
+		wantExplorationRatio float64
+		wantEvaluationScale  float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				options: MonteCarloOptions{Seed: 23},
+			},
+			wantPlayoutLength:    0,
+			wantExplorationRatio: math.Sqrt2,
+			wantEvaluationScale:  1,
+		},
+		{
+			args: args{
+				options: MonteCarloOptions{
+					Seed:             23,
+					PlayoutLength:    5,
+					ExplorationRatio: 2.3,
+					EvaluationScale:  4.2,
+				},
+			},
+			wantPlayoutLength:    5,
+			wantExplorationRatio: 2.3,
+			wantEvaluationScale:  4.2,
+		},
+	} {
+		var generator MockMoveGenerator
+		var terminator MockSearchTerminator
+		var evaluator MockBoardEvaluator
+		searcher := NewMonteCarloSearcher(
+			generator,
+			terminator,
+			evaluator,
+			data.args.options,
+		)
+
+		if !reflect.DeepEqual(searcher.generator, generator) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(searcher.terminator, terminator) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(searcher.evaluator, evaluator) {
+			test.Fail()
+		}
+		if searcher.randomizer == nil {
+			test.Fail()
+		}
+		if searcher.playoutLength != data.wantPlayoutLength {
+			test.Fail()
+		}
+		if searcher.explorationRatio != data.wantExplorationRatio {
+			test.Fail()
+		}
+		if searcher.evaluationScale != data.wantEvaluationScale {
+			test.Fail()
+		}
+	}
+}
+
+func TestMonteCarloSearcherSetSearcher(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		var innerSearcher MockMoveSearcher
+		var searcher MonteCarloSearcher
+		searcher.SetSearcher(innerSearcher)
+	}()
+
+	if err != "not supported" {
+		test.Fail()
+	}
+}
+
+func TestMonteCarloSearcherSearchMove(test *testing.T) {
+	type fields struct {
+		generator  MoveGenerator
+		terminator terminators.SearchTerminator
+		evaluator  MockBoardEvaluator
+	}
+	type args struct {
+		storage models.PieceStorage
+		color   models.Color
+		deep    int
+	}
+	type data struct {
+		fields   fields
+		args     args
+		wantMove moves.ScoredMove
+		wantErr  error
+	}
+
+	moveOne := models.Move{
+		Start:  models.Position{File: 1, Rank: 2},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+	moveTwo := models.Move{
+		Start:  models.Position{File: 5, Rank: 6},
+		Finish: models.Position{File: 7, Rank: 8},
+	}
+	var applyMove func(move models.Move) models.PieceStorage
+	applyMove = func(move models.Move) models.PieceStorage {
+		return MockPieceStorage{
+			appliedMove: move,
+			applyMove:   applyMove,
+		}
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						return nil, models.ErrKingCapture
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+			},
+			wantMove: moves.ScoredMove{},
+			wantErr:  models.ErrKingCapture,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						// black color means a call for checking, if a king
+						// is under an attack
+						if color == models.Black {
+							return nil, models.ErrKingCapture
+						}
+
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+			},
+			wantMove: moves.ScoredMove{Score: evaluateCheckmate(2)},
+			wantErr:  ErrCheckmate,
+		},
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    2,
+			},
+			wantMove: moves.ScoredMove{},
+			wantErr:  ErrDraw,
+		},
+		// move two leads to a checkmate, move one leads to a stalemate
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						mock := storage.(MockPieceStorage)
+						if mock.appliedMove.IsZero() {
+							if color == models.White {
+								return []models.Move{moveOne, moveTwo}, nil
+							}
+
+							return nil, nil
+						}
+
+						// white color means a call for checking, if a black king
+						// is under an attack
+						if color == models.White && mock.appliedMove == moveTwo {
+							return nil, models.ErrKingCapture
+						}
+
+						return nil, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						return deep == 10
+					},
+					searchProgress: func(deep int) float64 {
+						if deep != 2 {
+							test.Fail()
+						}
+
+						return 0.75
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{applyMove: applyMove},
+				color:   models.White,
+				deep:    2,
+			},
+			wantMove: moves.ScoredMove{
+				Move:    moveTwo,
+				Score:   -evaluateCheckmate(3),
+				Quality: 0.25,
+			},
+			wantErr: nil,
+		},
+		// move two leads to a checkmate, but move one is visited as often
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						mock := storage.(MockPieceStorage)
+						if mock.appliedMove.IsZero() {
+							if color == models.White {
+								return []models.Move{moveTwo, moveOne}, nil
+							}
+
+							return nil, nil
+						}
+
+						// white color means a call for checking, if a black king
+						// is under an attack
+						if color == models.White && mock.appliedMove == moveTwo {
+							return nil, models.ErrKingCapture
+						}
+
+						return nil, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						return deep == 2
+					},
+					searchProgress: func(deep int) float64 {
+						if deep != 2 {
+							test.Fail()
+						}
+
+						return 0.75
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{applyMove: applyMove},
+				color:   models.White,
+				deep:    2,
+			},
+			wantMove: moves.ScoredMove{
+				Move:    moveTwo,
+				Score:   -evaluateCheckmate(3),
+				Quality: 0.25,
+			},
+			wantErr: nil,
+		},
+		// move two leads to a better evaluation
+		{
+			fields: fields{
+				generator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						mock := storage.(MockPieceStorage)
+						if mock.appliedMove.IsZero() && color == models.White {
+							return []models.Move{moveOne, moveTwo}, nil
+						}
+						if !mock.appliedMove.IsZero() && color == models.Black {
+							return []models.Move{moveOne}, nil
+						}
+
+						return nil, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						return deep == 3
+					},
+					searchProgress: func(deep int) float64 {
+						return 0.75
+					},
+				},
+				evaluator: MockBoardEvaluator{
+					evaluateBoard: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) float64 {
+						mock := storage.(MockPieceStorage)
+						if color == models.Black {
+							// after a first white move
+							if mock.appliedMove == moveTwo {
+								return -5
+							}
+
+							return 5
+						}
+
+						// after a reply of black
+						return 0
+					},
+				},
+			},
+			args: args{
+				storage: MockPieceStorage{applyMove: applyMove},
+				color:   models.White,
+				deep:    0,
+			},
+			wantMove: moves.ScoredMove{
+				Move:    moveTwo,
+				Score:   math.Log(((1/(1+math.Exp(-5)))+0.5)/2) - math.Log(1-((1/(1+math.Exp(-5)))+0.5)/2),
+				Quality: 0.25,
+			},
+			wantErr: nil,
+		},
+	} {
+		searcher := MonteCarloSearcher{
+			TerminatorSetter: &TerminatorSetter{
+				terminator: data.fields.terminator,
+			},
+
+			generator:        data.fields.generator,
+			evaluator:        data.fields.evaluator,
+			randomizer:       rand.New(rand.NewSource(23)),
+			explorationRatio: math.Sqrt2,
+			evaluationScale:  1,
+		}
+
+		gotMove, gotErr := searcher.SearchMove(
+			data.args.storage,
+			data.args.color,
+			data.args.deep,
+			moves.NewBounds(),
+		)
+
+		if gotMove.Move != data.wantMove.Move {
+			test.Fail()
+		}
+		if math.Abs(gotMove.Score-data.wantMove.Score) > 1e-9 {
+			test.Fail()
+		}
+		if gotMove.Quality != data.wantMove.Quality {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestMonteCarloSearcherSearchMoveDeterminism(test *testing.T) {
+	generator := MockMoveGenerator{
+		movesForColor: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) ([]models.Move, error) {
+			var moveGroup []models.Move
+			for file := 0; file < 5; file++ {
+				moveGroup = append(moveGroup, models.Move{
+					Start:  models.Position{File: file, Rank: int(color)},
+					Finish: models.Position{File: file, Rank: int(color) + 1},
+				})
+			}
+
+			return moveGroup, nil
+		},
+	}
+	evaluator := MockBoardEvaluator{
+		evaluateBoard: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) float64 {
+			move := storage.(MockPieceStorage).appliedMove
+			return float64(move.Start.File) - 2
+		},
+	}
+	var applyMove func(move models.Move) models.PieceStorage
+	applyMove = func(move models.Move) models.PieceStorage {
+		return MockPieceStorage{
+			appliedMove: move,
+			applyMove:   applyMove,
+		}
+	}
+
+	var results []moves.ScoredMove
+	for i := 0; i < 2; i++ {
+		terminator := terminators.NewDeepTerminator(100)
+		searcher := NewMonteCarloSearcher(
+			generator,
+			terminator,
+			evaluator,
+			MonteCarloOptions{Seed: 23, PlayoutLength: 3},
+		)
+
+		storage := MockPieceStorage{applyMove: applyMove}
+		move, err := searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+		if err != nil {
+			test.Fail()
+		}
+
+		results = append(results, move)
+	}
+
+	if !reflect.DeepEqual(results[0], results[1]) {
+		test.Fail()
+	}
+}
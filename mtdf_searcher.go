@@ -0,0 +1,117 @@
+package chessminimax
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// MTDFSearcher ...
+//
+// It implements the MTD(f) algorithm: it repeatedly calls the inner searcher
+// with null windows converging on a minimax value. The inner searcher should
+// be bound to CachedSearcher, otherwise repeated searches are too expensive.
+//
+// A first guess is a score of a previous search, so it's natural to use it
+// inside IterativeSearcher. The guess is kept between searches of different
+// positions too, so on a new position it should be reset via ResetFirstGuess();
+// otherwise, a search converges slower, but its result is the same.
+type MTDFSearcher struct {
+	*SearcherSetter
+	*TerminatorSetter
+
+	precision  float64
+	firstGuess *float64
+}
+
+// NewMTDFSearcher ...
+//
+// The precision is a width of a null window; it should be not greater
+// than a minimal difference between scores of the used evaluator.
+//
+// It panics, if the precision isn't positive, because otherwise the search
+// would never converge.
+func NewMTDFSearcher(
+	innerSearcher MoveSearcher,
+	terminator terminators.SearchTerminator,
+	precision float64,
+) MTDFSearcher {
+	if precision <= 0 {
+		panic("non-positive precision")
+	}
+
+	searcher := MTDFSearcher{
+		SearcherSetter:   new(SearcherSetter),
+		TerminatorSetter: new(TerminatorSetter),
+
+		precision:  precision,
+		firstGuess: new(float64),
+	}
+
+	searcher.SetSearcher(innerSearcher)
+	searcher.SetTerminator(terminator)
+
+	return searcher
+}
+
+// SetTerminator ...
+//
+// It also sets the terminator to the inner searcher.
+func (searcher MTDFSearcher) SetTerminator(
+	terminator terminators.SearchTerminator,
+) {
+	searcher.TerminatorSetter.SetTerminator(terminator)
+	searcher.searcher.SetTerminator(terminator)
+}
+
+// ResetFirstGuess ...
+//
+// It resets the first guess to a null score.
+func (searcher MTDFSearcher) ResetFirstGuess() {
+	*searcher.firstGuess = 0
+}
+
+// SearchMove ...
+func (searcher MTDFSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	guess := *searcher.firstGuess
+	lowerBound, upperBound := bounds.Alpha, bounds.Beta
+	var bestMove moves.ScoredMove
+	for upperBound-lowerBound >= searcher.precision {
+		beta := guess
+		if beta-searcher.precision < lowerBound {
+			beta = lowerBound + searcher.precision
+		}
+
+		nullBounds := moves.Bounds{Alpha: beta - searcher.precision, Beta: beta}
+		move, err :=
+			searcher.searcher.SearchMove(storage, color, deep, nullBounds)
+		if err != nil {
+			return move, err
+		}
+
+		guess = move.Score
+		if guess < beta {
+			upperBound = guess
+		} else {
+			lowerBound = guess
+		}
+
+		// a move is reliable only on a fail high,
+		// because it's actually better than others then
+		if guess >= beta || bestMove.Move.IsZero() {
+			bestMove = move
+		}
+
+		if searcher.terminator.IsSearchTerminated(deep) {
+			break
+		}
+	}
+
+	*searcher.firstGuess = guess
+	return bestMove, nil
+}
@@ -0,0 +1,123 @@
+// +build long
+
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+func TestMTDFSearcher(test *testing.T) {
+	type args struct {
+		boardInFEN  string
+		color       models.Color
+		maximalDeep int
+	}
+	type data struct {
+		args      args
+		wantScore float64
+		wantErr   error
+	}
+
+	for _, data := range []data{
+		// king capture
+		{
+			args: args{
+				boardInFEN:  "7K/8/8/8/8/8/8/k6R",
+				color:       models.White,
+				maximalDeep: 0,
+			},
+			wantScore: 0,
+			wantErr:   models.ErrKingCapture,
+		},
+		// draw without checks
+		{
+			args: args{
+				boardInFEN:  "7K/8/8/8/8/8/pp6/kp6",
+				color:       models.Black,
+				maximalDeep: 1,
+			},
+			wantScore: 0,
+			wantErr:   ErrDraw,
+		},
+		// checkmate on a first ply
+		{
+			args: args{
+				boardInFEN:  "6BK/8/8/8/8/pp6/k6R/7R",
+				color:       models.Black,
+				maximalDeep: 1,
+			},
+			wantScore: evaluateCheckmate(0),
+			wantErr:   ErrCheckmate,
+		},
+		// checkmate on a second ply
+		{
+			args: args{
+				boardInFEN:  "6K1/8/7q/6p1/8/2B5/pp4PQ/k7",
+				color:       models.White,
+				maximalDeep: 2,
+			},
+			wantScore: -evaluateCheckmate(1),
+			wantErr:   nil,
+		},
+		// single profitable move on a first ply
+		{
+			args: args{
+				boardInFEN:  "7K/8/7q/8/8/8/7Q/k7",
+				color:       models.White,
+				maximalDeep: 1,
+			},
+			wantScore: 9,
+			wantErr:   nil,
+		},
+		// single profitable move on a third ply
+		{
+			args: args{
+				boardInFEN:  "kn6/n6q/PP6/8/8/8/7P/7K",
+				color:       models.White,
+				maximalDeep: 3,
+			},
+			wantScore: -4,
+			wantErr:   nil,
+		},
+	} {
+		cache := caches.NewStringHashingCache(1e6, uci.EncodePieceStorage)
+
+		// increase the limit, because the iterative searcher discards a result
+		// of the last iteration, if it's not the only one
+		if data.args.maximalDeep > 1 {
+			data.args.maximalDeep++
+		}
+
+		gotMove, gotErr := mtdfSearch(
+			cache,
+			data.args.boardInFEN,
+			data.args.color,
+			data.args.maximalDeep,
+		)
+
+		if gotMove.Score != data.wantScore {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotErr, data.wantErr) {
+			test.Fail()
+		}
+
+		// a value of MTD(f) should be the same as a value of alpha-beta pruning
+		if gotErr == nil {
+			wantMove, _ := iterativeSearch(
+				caches.NewStringHashingCache(1e6, uci.EncodePieceStorage),
+				data.args.boardInFEN,
+				data.args.color,
+				data.args.maximalDeep,
+			)
+			if gotMove.Score != wantMove.Score {
+				test.Fail()
+			}
+		}
+	}
+}
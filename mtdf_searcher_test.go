@@ -0,0 +1,276 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewMTDFSearcher(test *testing.T) {
+	var terminator MockSearchTerminator
+	var innerTerminator terminators.SearchTerminator
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {
+			innerTerminator = terminator
+		},
+	}
+	searcher := NewMTDFSearcher(innerSearcher, terminator, 0.5)
+
+	if _, ok := searcher.searcher.(MockMoveSearcher); !ok {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.terminator, terminator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(innerTerminator, terminator) {
+		test.Fail()
+	}
+	if searcher.precision != 0.5 {
+		test.Fail()
+	}
+	if searcher.firstGuess == nil || *searcher.firstGuess != 0 {
+		test.Fail()
+	}
+}
+
+func TestNewMTDFSearcher_withNonPositivePrecision(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		var innerSearcher MockMoveSearcher
+		var terminator MockSearchTerminator
+		NewMTDFSearcher(innerSearcher, terminator, 0)
+	}()
+
+	if err != "non-positive precision" {
+		test.Fail()
+	}
+}
+
+func TestMTDFSearcherSetTerminator(test *testing.T) {
+	var terminator MockSearchTerminator
+	var innerTerminator terminators.SearchTerminator
+	searcher := MTDFSearcher{
+		SearcherSetter: &SearcherSetter{
+			searcher: MockMoveSearcher{
+				setTerminator: func(terminator terminators.SearchTerminator) {
+					innerTerminator = terminator
+				},
+			},
+		},
+		TerminatorSetter: new(TerminatorSetter),
+	}
+	searcher.SetTerminator(terminator)
+
+	if !reflect.DeepEqual(searcher.terminator, terminator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(innerTerminator, terminator) {
+		test.Fail()
+	}
+}
+
+func TestMTDFSearcherResetFirstGuess(test *testing.T) {
+	firstGuess := 2.3
+	searcher := MTDFSearcher{firstGuess: &firstGuess}
+	searcher.ResetFirstGuess()
+
+	if firstGuess != 0 {
+		test.Fail()
+	}
+}
+
+func TestMTDFSearcherSearchMove(test *testing.T) {
+	type fields struct {
+		searcher   MoveSearcher
+		terminator terminators.SearchTerminator
+		precision  float64
+		firstGuess float64
+	}
+	type args struct {
+		storage models.PieceStorage
+		color   models.Color
+		deep    int
+		bounds  moves.Bounds
+	}
+	type data struct {
+		fields         fields
+		args           args
+		wantMove       moves.ScoredMove
+		wantErr        error
+		wantFirstGuess float64
+		wantBounds     []moves.Bounds
+	}
+
+	moveOne := models.Move{
+		Start:  models.Position{File: 1, Rank: 2},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+	moveTwo := models.Move{
+		Start:  models.Position{File: 5, Rank: 6},
+		Finish: models.Position{File: 7, Rank: 8},
+	}
+	var gotBounds []moves.Bounds
+	for _, data := range []data{
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						gotBounds = append(gotBounds, bounds)
+						return moves.ScoredMove{Score: -5}, ErrCheckmate
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						return false
+					},
+				},
+				precision:  0.5,
+				firstGuess: 2,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    0,
+				bounds:  moves.NewBounds(),
+			},
+			wantMove:       moves.ScoredMove{Score: -5},
+			wantErr:        ErrCheckmate,
+			wantFirstGuess: 2,
+			wantBounds:     []moves.Bounds{{Alpha: 1.5, Beta: 2}},
+		},
+		// a minimax value is 3; a fail soft searcher is simulated
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						if _, ok := storage.(MockPieceStorage); !ok {
+							test.Fail()
+						}
+						if color != models.White {
+							test.Fail()
+						}
+						if deep != 0 {
+							test.Fail()
+						}
+
+						gotBounds = append(gotBounds, bounds)
+
+						// a move of a fail low shouldn't be used
+						if bounds.Beta <= 1 {
+							return moves.ScoredMove{Move: moveOne, Score: 3}, nil
+						}
+
+						return moves.ScoredMove{Move: moveTwo, Score: 3}, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						if deep != 0 {
+							test.Fail()
+						}
+
+						return false
+					},
+				},
+				precision:  0.5,
+				firstGuess: 1,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    0,
+				bounds:  moves.NewBounds(),
+			},
+			wantMove:       moves.ScoredMove{Move: moveOne, Score: 3},
+			wantErr:        nil,
+			wantFirstGuess: 3,
+			wantBounds: []moves.Bounds{
+				{Alpha: 0.5, Beta: 1},
+				{Alpha: 3, Beta: 3.5},
+			},
+		},
+		// a termination
+		{
+			fields: fields{
+				searcher: MockMoveSearcher{
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						gotBounds = append(gotBounds, bounds)
+						return moves.ScoredMove{Move: moveOne, Score: -1}, nil
+					},
+				},
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool {
+						return true
+					},
+				},
+				precision:  0.5,
+				firstGuess: 1,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+				deep:    0,
+				bounds:  moves.NewBounds(),
+			},
+			wantMove:       moves.ScoredMove{Move: moveOne, Score: -1},
+			wantErr:        nil,
+			wantFirstGuess: -1,
+			wantBounds:     []moves.Bounds{{Alpha: 0.5, Beta: 1}},
+		},
+	} {
+		gotBounds = nil
+
+		firstGuess := data.fields.firstGuess
+		searcher := MTDFSearcher{
+			SearcherSetter: &SearcherSetter{
+				searcher: data.fields.searcher,
+			},
+			TerminatorSetter: &TerminatorSetter{
+				terminator: data.fields.terminator,
+			},
+
+			precision:  data.fields.precision,
+			firstGuess: &firstGuess,
+		}
+
+		gotMove, gotErr := searcher.SearchMove(
+			data.args.storage,
+			data.args.color,
+			data.args.deep,
+			data.args.bounds,
+		)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+		if firstGuess != data.wantFirstGuess {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotBounds, data.wantBounds) {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package observers
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// SearchObserver ...
+//
+// Its methods are called from goroutines of searchers, so an implementation
+// should be safe for concurrent use, if it's shared by concurrent searchers.
+type SearchObserver interface {
+	IterationStarted(deep int)
+	IterationFinished(deep int, move moves.FailedMove)
+
+	// the number of a move starts from one
+	RootMoveStarted(move models.Move, number int)
+	BestRootMoveChanged(move moves.ScoredMove)
+
+	CacheHit(deep int)
+	Cutoff(deep int, move models.Move)
+}
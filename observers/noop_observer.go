@@ -0,0 +1,29 @@
+package observers
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// NoopObserver ...
+//
+// It ignores all events; it's used by searchers by default.
+type NoopObserver struct{}
+
+// IterationStarted ...
+func (NoopObserver) IterationStarted(deep int) {}
+
+// IterationFinished ...
+func (NoopObserver) IterationFinished(deep int, move moves.FailedMove) {}
+
+// RootMoveStarted ...
+func (NoopObserver) RootMoveStarted(move models.Move, number int) {}
+
+// BestRootMoveChanged ...
+func (NoopObserver) BestRootMoveChanged(move moves.ScoredMove) {}
+
+// CacheHit ...
+func (NoopObserver) CacheHit(deep int) {}
+
+// Cutoff ...
+func (NoopObserver) Cutoff(deep int, move models.Move) {}
@@ -0,0 +1,20 @@
+package observers
+
+import (
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNoopObserver(test *testing.T) {
+	var observer SearchObserver = NoopObserver{}
+
+	// it should do nothing without a panic
+	observer.IterationStarted(2)
+	observer.IterationFinished(2, moves.FailedMove{})
+	observer.RootMoveStarted(models.Move{}, 1)
+	observer.BestRootMoveChanged(moves.ScoredMove{})
+	observer.CacheHit(2)
+	observer.Cutoff(2, models.Move{})
+}
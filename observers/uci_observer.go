@@ -0,0 +1,95 @@
+package observers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// UCIObserver ...
+//
+// It formats events as "info" lines of the UCI protocol. Events
+// without a correspondence in the protocol (a best root move change,
+// a cache hit and a cutoff) are ignored.
+//
+// It's safe for concurrent use.
+type UCIObserver struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+// NewUCIObserver ...
+func NewUCIObserver(writer io.Writer) *UCIObserver {
+	return &UCIObserver{writer: writer}
+}
+
+// IterationStarted ...
+func (observer *UCIObserver) IterationStarted(deep int) {
+	observer.writeInfo("depth %d", deep)
+}
+
+// IterationFinished ...
+func (observer *UCIObserver) IterationFinished(
+	deep int,
+	move moves.FailedMove,
+) {
+	info := []string{
+		fmt.Sprintf("depth %d", deep),
+		"score " + encodeScore(move.Move.Score),
+	}
+	if !move.Move.Move.IsZero() {
+		info = append(info, "pv "+EncodeMove(move.Move.Move))
+	}
+
+	observer.writeInfo("%s", strings.Join(info, " "))
+}
+
+// RootMoveStarted ...
+func (observer *UCIObserver) RootMoveStarted(move models.Move, number int) {
+	observer.writeInfo("currmove %s currmovenumber %d", EncodeMove(move), number)
+}
+
+// BestRootMoveChanged ...
+func (observer *UCIObserver) BestRootMoveChanged(move moves.ScoredMove) {}
+
+// CacheHit ...
+func (observer *UCIObserver) CacheHit(deep int) {}
+
+// Cutoff ...
+func (observer *UCIObserver) Cutoff(deep int, move models.Move) {}
+
+func (observer *UCIObserver) writeInfo(
+	format string,
+	arguments ...interface{},
+) {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+
+	line := fmt.Sprintf("info "+format+"\n", arguments...)
+	io.WriteString(observer.writer, line) // nolint: errcheck
+}
+
+func encodeScore(rawScore float64) string {
+	score := moves.ParseScore(rawScore)
+	if score.Kind == moves.MateScore {
+		return fmt.Sprintf("mate %d", score.Mate)
+	}
+
+	return fmt.Sprintf("cp %d", score.Centipawns())
+}
+
+// EncodeMove ...
+//
+// It encodes a move in the long algebraic notation used by the UCI protocol,
+// e.g. "e2e4".
+func EncodeMove(move models.Move) string {
+	return encodePosition(move.Start) + encodePosition(move.Finish)
+}
+
+func encodePosition(position models.Position) string {
+	return fmt.Sprintf("%c%d", 'a'+position.File, position.Rank+1)
+}
@@ -0,0 +1,94 @@
+package observers
+
+import (
+	"bytes"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewUCIObserver(test *testing.T) {
+	var buffer bytes.Buffer
+	observer := NewUCIObserver(&buffer)
+
+	if observer.writer != &buffer {
+		test.Fail()
+	}
+}
+
+func TestUCIObserver(test *testing.T) {
+	type data struct {
+		notify   func(observer *UCIObserver)
+		wantLine string
+	}
+
+	e2e4 := models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	}
+	for _, data := range []data{
+		{
+			notify:   func(observer *UCIObserver) { observer.IterationStarted(2) },
+			wantLine: "info depth 2\n",
+		},
+		{
+			notify: func(observer *UCIObserver) {
+				observer.IterationFinished(2, moves.FailedMove{
+					Move: moves.ScoredMove{Move: e2e4, Score: 1.25},
+				})
+			},
+			wantLine: "info depth 2 score cp 125 pv e2e4\n",
+		},
+		{
+			notify: func(observer *UCIObserver) {
+				observer.IterationFinished(3, moves.FailedMove{
+					Move: moves.ScoredMove{
+						Move:  e2e4,
+						Score: -moves.NewCheckmateScore(3),
+					},
+				})
+			},
+			wantLine: "info depth 3 score mate 2 pv e2e4\n",
+		},
+		{
+			notify: func(observer *UCIObserver) {
+				observer.IterationFinished(1, moves.FailedMove{
+					Move: moves.ScoredMove{Score: 0},
+				})
+			},
+			wantLine: "info depth 1 score cp 0\n",
+		},
+		{
+			notify: func(observer *UCIObserver) {
+				observer.RootMoveStarted(e2e4, 3)
+			},
+			wantLine: "info currmove e2e4 currmovenumber 3\n",
+		},
+		{
+			notify: func(observer *UCIObserver) {
+				observer.BestRootMoveChanged(moves.ScoredMove{Move: e2e4})
+				observer.CacheHit(2)
+				observer.Cutoff(2, e2e4)
+			},
+			wantLine: "",
+		},
+	} {
+		var buffer bytes.Buffer
+		data.notify(NewUCIObserver(&buffer))
+
+		if got := buffer.String(); got != data.wantLine {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeMove(test *testing.T) {
+	move := models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	}
+	if got := EncodeMove(move); got != "e2e4" {
+		test.Fail()
+	}
+}
@@ -1,33 +1,79 @@
 package chessminimax
 
 import (
+	"sync"
+	"sync/atomic"
+	"time"
+
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
 
 // MoveSearcherFactory ...
-type MoveSearcherFactory func() MoveSearcher
+//
+// It receives an index of a worker in the range [0, concurrency),
+// so workers can be diversified, e.g. by a deep schedule
+// of IterativeSearcher or by RotatedMoveGenerator.
+type MoveSearcherFactory func(index int) MoveSearcher
 
 // ParallelSearcher ...
+//
+// After a first result it terminates other workers of the search and waits
+// for them to stop. A deadline of this waiting can be set
+// via SetShutdownTimeout(). Then it selects a result from ones of stopped
+// workers via a result selector.
+//
+// Only a main worker (with an index 0) completes iterations of the terminator
+// (see terminators.IterationTerminator), so the terminator observes
+// a consistent sequence of iterations, e.g. for reporting by Analyzer.
+// For the same reason, only the main worker receives the observer.
 type ParallelSearcher struct {
 	*TerminatorSetter
+	*ObserverSetter
 
 	concurrency int
 	factory     MoveSearcherFactory
+	selector    ResultSelector
+	workers     *workerGroup
+}
+
+// it hides completion of iterations of helper workers
+type helperTerminator struct {
+	terminators.SearchTerminator
+}
+
+// its zero value is ready for use; workers are counted with a mutex
+// and a condition variable instead of sync.WaitGroup, because searches
+// may add workers during waiting for them
+type workerGroup struct {
+	locker          sync.Mutex
+	stopping        *sync.Cond
+	activeWorkers   int
+	shutdownTimeout int64 // time.Duration
 }
 
 // NewParallelSearcher ...
+//
+// A nil selector means SelectFirstResult().
 func NewParallelSearcher(
 	terminator terminators.SearchTerminator,
 	concurrency int,
 	factory MoveSearcherFactory,
+	selector ResultSelector,
 ) ParallelSearcher {
+	if selector == nil {
+		selector = SelectFirstResult
+	}
+
 	searcher := ParallelSearcher{
 		TerminatorSetter: new(TerminatorSetter),
+		ObserverSetter:   new(ObserverSetter),
 
 		concurrency: concurrency,
 		factory:     factory,
+		selector:    selector,
+		workers:     new(workerGroup),
 	}
 
 	searcher.SetTerminator(terminator)
@@ -45,6 +91,47 @@ func (ParallelSearcher) SetSearcher(innerSearcher MoveSearcher) {
 	panic("not supported")
 }
 
+// SetShutdownTimeout ...
+//
+// It sets a deadline of waiting for workers to stop after a first result.
+// A zero value (by default) means waiting without a deadline. Workers that
+// haven't stopped before the deadline are still observable
+// via ActiveWorkers() and Wait().
+//
+// It's safe for concurrent use.
+func (searcher ParallelSearcher) SetShutdownTimeout(timeout time.Duration) {
+	atomic.StoreInt64(&searcher.workers.shutdownTimeout, int64(timeout))
+}
+
+// ActiveWorkers ...
+//
+// It returns a number of workers that are still running, including ones
+// of searches that have already returned a result.
+//
+// It's safe for concurrent use.
+func (searcher ParallelSearcher) ActiveWorkers() int {
+	searcher.workers.locker.Lock()
+	defer searcher.workers.locker.Unlock()
+
+	return searcher.workers.activeWorkers
+}
+
+// Wait ...
+//
+// It blocks until all workers of all searches have stopped. If searches
+// are started concurrently with it, it returns at a first moment without
+// running workers.
+//
+// It's safe for concurrent use, including with SearchMove().
+func (searcher ParallelSearcher) Wait() {
+	searcher.workers.locker.Lock()
+	defer searcher.workers.locker.Unlock()
+
+	for searcher.workers.activeWorkers != 0 {
+		searcher.workers.condition().Wait()
+	}
+}
+
 // SearchMove ...
 func (searcher ParallelSearcher) SearchMove(
 	storage models.PieceStorage,
@@ -55,19 +142,113 @@ func (searcher ParallelSearcher) SearchMove(
 	manualTerminator := new(terminators.ManualTerminator)
 	terminator :=
 		terminators.NewGroupTerminator(searcher.terminator, manualTerminator)
-	buffer := make(chan moves.FailedMove, searcher.concurrency)
+	observer := searcher.currentObserver()
+	buffer := make(chan WorkerResult, searcher.concurrency)
+	searchWaiter := new(sync.WaitGroup)
+	searchWaiter.Add(searcher.concurrency)
+	searcher.workers.add(searcher.concurrency)
 	for i := 0; i < searcher.concurrency; i++ {
-		go func() {
-			searcher := searcher.factory()
-			searcher.SetTerminator(terminator)
+		go func(index int) {
+			defer searchWaiter.Done()
+			defer searcher.workers.done()
+
+			searcher := searcher.factory(index)
+			if index == 0 {
+				searcher.SetTerminator(terminator)
+				setObserver(searcher, observer)
+			} else {
+				searcher.SetTerminator(helperTerminator{terminator})
+			}
 
 			move, err := searcher.SearchMove(storage, color, deep, bounds)
-			buffer <- moves.FailedMove{Move: move, Error: err}
-		}()
+			result := WorkerResult{
+				FailedMove: moves.FailedMove{Move: move, Error: err},
+			}
+			if reporter, ok := searcher.(DeepReporter); ok {
+				result.Deep = reporter.CompletedDeep()
+			}
+
+			buffer <- result
+		}(i)
 	}
 
-	move := <-buffer
+	results := []WorkerResult{<-buffer}
 	manualTerminator.Terminate()
+	searcher.waitWorkers(searchWaiter)
+
+	// take results only of workers that have stopped before a deadline
+	for len(buffer) != 0 {
+		results = append(results, <-buffer)
+	}
+
+	result := searcher.selector(results)
+	return result.Move, result.Error
+}
+
+func (searcher ParallelSearcher) waitWorkers(searchWaiter *sync.WaitGroup) {
+	timeout :=
+		time.Duration(atomic.LoadInt64(&searcher.workers.shutdownTimeout))
+	if timeout == 0 {
+		searchWaiter.Wait()
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		searchWaiter.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+	}
+}
+
+// CountNode ...
+func (terminator helperTerminator) CountNode(deep int) {
+	terminators.CountNode(terminator.SearchTerminator, deep)
+}
+
+// IsIterationTerminated ...
+func (terminator helperTerminator) IsIterationTerminated(deep int) bool {
+	return terminators.IsIterationTerminated(terminator.SearchTerminator, deep)
+}
+
+// CompleteIteration ...
+//
+// It does nothing.
+func (terminator helperTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+}
+
+func (workers *workerGroup) add(count int) {
+	workers.locker.Lock()
+	defer workers.locker.Unlock()
+
+	workers.activeWorkers += count
+}
+
+func (workers *workerGroup) done() {
+	workers.locker.Lock()
+	defer workers.locker.Unlock()
+
+	workers.activeWorkers--
+	if workers.activeWorkers == 0 {
+		workers.condition().Broadcast()
+	}
+}
+
+// it should be called only under the locker
+func (workers *workerGroup) condition() *sync.Cond {
+	if workers.stopping == nil {
+		workers.stopping = sync.NewCond(&workers.locker)
+	}
 
-	return move.Move, move.Error
+	return workers.stopping
 }
@@ -50,9 +50,12 @@ func parallelSearch(
 	}
 
 	terminator := terminators.NewDeepTerminator(maximalDeep)
-	searcher :=
-		NewParallelSearcher(terminator, runtime.NumCPU(), func() MoveSearcher {
-			var generator models.MoveGenerator
+	searcher := NewParallelSearcher(
+		terminator,
+		runtime.NumCPU(),
+		func(index int) MoveSearcher {
+			// try moves in different orders in different workers
+			generator := NewRotatedMoveGenerator(models.MoveGenerator{}, index)
 			var evaluator evaluators.MaterialEvaluator
 			innerSearcher := NewAlphaBetaSearcher(
 				generator,
@@ -67,7 +70,9 @@ func parallelSearch(
 				innerSearcher,
 				nil, // terminator will be set automatically by the parallel searcher
 			)
-		})
+		},
+		SelectDeepestResult,
+	)
 
 	return searcher.SearchMove(
 		storage,
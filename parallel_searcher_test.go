@@ -3,31 +3,84 @@ package chessminimax
 import (
 	"errors"
 	"reflect"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
 
+type MockObservableSearcher struct {
+	MockMoveSearcher
+
+	setObserver func(observer observers.SearchObserver)
+}
+
+func (searcher MockObservableSearcher) SetObserver(
+	observer observers.SearchObserver,
+) {
+	if searcher.setObserver == nil {
+		panic("not implemented")
+	}
+
+	searcher.setObserver(observer)
+}
+
+type MockDeepSearcher struct {
+	MockMoveSearcher
+
+	completedDeep int
+}
+
+func (searcher MockDeepSearcher) CompletedDeep() int {
+	return searcher.completedDeep
+}
+
 func TestNewParallelSearcher(test *testing.T) {
 	var terminator MockSearchTerminator
-	factory := func() MoveSearcher {
+	factory := func(index int) MoveSearcher {
 		panic("not implemented")
 	}
-	searcher := NewParallelSearcher(terminator, 10, factory)
+	searcher :=
+		NewParallelSearcher(terminator, 10, factory, SelectDeepestResult)
 
 	if searcher.concurrency != 10 {
 		test.Fail()
 	}
+	if searcher.workers == nil {
+		test.Fail()
+	}
 
 	gotFactory := reflect.ValueOf(searcher.factory).Pointer()
 	wantFactory := reflect.ValueOf(factory).Pointer()
 	if gotFactory != wantFactory {
 		test.Fail()
 	}
+
+	gotSelector := reflect.ValueOf(searcher.selector).Pointer()
+	wantSelector := reflect.ValueOf(SelectDeepestResult).Pointer()
+	if gotSelector != wantSelector {
+		test.Fail()
+	}
+}
+
+func TestNewParallelSearcher_withDefaultSelector(test *testing.T) {
+	var terminator MockSearchTerminator
+	factory := func(index int) MoveSearcher {
+		panic("not implemented")
+	}
+	searcher := NewParallelSearcher(terminator, 10, factory, nil)
+
+	gotSelector := reflect.ValueOf(searcher.selector).Pointer()
+	wantSelector := reflect.ValueOf(SelectFirstResult).Pointer()
+	if gotSelector != wantSelector {
+		test.Fail()
+	}
 }
 
 func TestParallelSearcherSetSearcher(test *testing.T) {
@@ -64,6 +117,8 @@ func TestParallelSearcherSearchMove(test *testing.T) {
 
 	var factoryWaiter *sync.WaitGroup
 	var factoryCount uint64
+	var factoryIndexLock sync.Mutex
+	var factoryIndexes map[int]struct{}
 	var searcherCount uint64
 	var expectedMove moves.ScoredMove
 	var expectedErr error
@@ -73,12 +128,22 @@ func TestParallelSearcherSearchMove(test *testing.T) {
 			fields: fields{
 				terminator:  MockSearchTerminator{},
 				concurrency: 10,
-				factory: func() MoveSearcher {
+				factory: func(index int) MoveSearcher {
 					atomic.AddUint64(&factoryCount, 1)
 
+					factoryIndexLock.Lock()
+					factoryIndexes[index] = struct{}{}
+					factoryIndexLock.Unlock()
+
 					return MockMoveSearcher{
 						setTerminator: func(terminator terminators.SearchTerminator) {
-							if _, ok := terminator.(terminators.GroupTerminator); !ok {
+							var ok bool
+							if index == 0 {
+								_, ok = terminator.(terminators.GroupTerminator)
+							} else {
+								_, ok = terminator.(helperTerminator)
+							}
+							if !ok {
 								test.Fail()
 							}
 						},
@@ -141,6 +206,7 @@ func TestParallelSearcherSearchMove(test *testing.T) {
 		factoryWaiter.Add(data.fields.concurrency)
 
 		atomic.StoreUint64(&factoryCount, 0)
+		factoryIndexes = make(map[int]struct{})
 		atomic.StoreUint64(&searcherCount, 0)
 
 		searcher := ParallelSearcher{
@@ -150,6 +216,8 @@ func TestParallelSearcherSearchMove(test *testing.T) {
 
 			concurrency: data.fields.concurrency,
 			factory:     data.fields.factory,
+			selector:    SelectFirstResult,
+			workers:     new(workerGroup),
 		}
 
 		gotMove, gotErr := searcher.SearchMove(
@@ -175,5 +243,393 @@ func TestParallelSearcherSearchMove(test *testing.T) {
 		if gotSearcherCount != uint64(data.fields.concurrency) {
 			test.Fail()
 		}
+		for index := 0; index < data.fields.concurrency; index++ {
+			if _, ok := factoryIndexes[index]; !ok {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestParallelSearcherSetShutdownTimeout(test *testing.T) {
+	searcher := ParallelSearcher{workers: new(workerGroup)}
+	searcher.SetShutdownTimeout(time.Second)
+
+	if searcher.workers.shutdownTimeout != int64(time.Second) {
+		test.Fail()
+	}
+}
+
+func TestParallelSearcherSearchMove_withShutdown(test *testing.T) {
+	goroutineCount := runtime.NumGoroutine()
+
+	searcher := NewParallelSearcher(
+		MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool {
+				return false
+			},
+		},
+		10,
+		func(index int) MoveSearcher {
+			var terminator terminators.SearchTerminator
+			return MockMoveSearcher{
+				setTerminator: func(innerTerminator terminators.SearchTerminator) {
+					terminator = innerTerminator
+				},
+				searchMove: func(
+					storage models.PieceStorage,
+					color models.Color,
+					deep int,
+					bounds moves.Bounds,
+				) (moves.ScoredMove, error) {
+					// only a main worker finishes by itself
+					if index != 0 {
+						for !terminator.IsSearchTerminated(deep) {
+							time.Sleep(time.Millisecond)
+						}
+					}
+
+					return moves.ScoredMove{Score: 2.3}, nil
+				},
+			}
+		},
+		nil,
+	)
+
+	gotMove, gotErr := searcher.SearchMove(
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	if !reflect.DeepEqual(gotMove, moves.ScoredMove{Score: 2.3}) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	if searcher.ActiveWorkers() != 0 {
+		test.Fail()
+	}
+	if !waitGoroutineCount(goroutineCount) {
+		test.Fail()
+	}
+}
+
+func TestParallelSearcherWait_concurrently(test *testing.T) {
+	searcher := NewParallelSearcher(
+		MockSearchTerminator{},
+		10,
+		func(index int) MoveSearcher {
+			return MockMoveSearcher{
+				setTerminator: func(terminator terminators.SearchTerminator) {},
+				searchMove: func(
+					storage models.PieceStorage,
+					color models.Color,
+					deep int,
+					bounds moves.Bounds,
+				) (moves.ScoredMove, error) {
+					return moves.ScoredMove{Score: 2.3}, nil
+				},
+			}
+		},
+		nil,
+	)
+
+	searchesDone := make(chan struct{})
+	go func() {
+		defer close(searchesDone)
+
+		for i := 0; i < 100; i++ {
+			searcher.SearchMove( // nolint: errcheck
+				MockPieceStorage{},
+				models.White,
+				0,
+				moves.NewBounds(),
+			)
+		}
+	}()
+
+	// waiting overlaps starting of new searches
+	for waiting := true; waiting; {
+		searcher.Wait()
+
+		select {
+		case <-searchesDone:
+			waiting = false
+		default:
+		}
+	}
+	searcher.Wait()
+
+	if searcher.ActiveWorkers() != 0 {
+		test.Fail()
+	}
+}
+
+func TestParallelSearcherSearchMove_withShutdownTimeout(test *testing.T) {
+	goroutineCount := runtime.NumGoroutine()
+
+	release := make(chan struct{})
+	searcher := NewParallelSearcher(
+		MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool {
+				return false
+			},
+		},
+		10,
+		func(index int) MoveSearcher {
+			return MockMoveSearcher{
+				setTerminator: func(terminator terminators.SearchTerminator) {},
+				searchMove: func(
+					storage models.PieceStorage,
+					color models.Color,
+					deep int,
+					bounds moves.Bounds,
+				) (moves.ScoredMove, error) {
+					// other workers ignore the terminator
+					if index != 0 {
+						<-release
+					}
+
+					return moves.ScoredMove{Score: 2.3}, nil
+				},
+			}
+		},
+		nil,
+	)
+	searcher.SetShutdownTimeout(10 * time.Millisecond)
+
+	gotMove, gotErr := searcher.SearchMove(
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	if !reflect.DeepEqual(gotMove, moves.ScoredMove{Score: 2.3}) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	if searcher.ActiveWorkers() != 9 {
+		test.Fail()
+	}
+
+	close(release)
+	searcher.Wait()
+
+	if searcher.ActiveWorkers() != 0 {
+		test.Fail()
+	}
+	if !waitGoroutineCount(goroutineCount) {
+		test.Fail()
+	}
+}
+
+func TestParallelSearcherSearchMove_withIterationTerminator(
+	test *testing.T,
+) {
+	var completionLock sync.Mutex
+	var completedMoves []moves.FailedMove
+	terminator := MockIterationTerminator{
+		MockSearchTerminator: MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool { return false },
+		},
+		isIterationTerminated: func(deep int) bool { return false },
+		completeIteration: func(deep int, move moves.FailedMove) {
+			completionLock.Lock()
+			defer completionLock.Unlock()
+
+			completedMoves = append(completedMoves, move)
+		},
+	}
+	searcher := NewParallelSearcher(
+		terminator,
+		10,
+		func(index int) MoveSearcher {
+			var terminator terminators.SearchTerminator
+			return MockMoveSearcher{
+				setTerminator: func(innerTerminator terminators.SearchTerminator) {
+					terminator = innerTerminator
+				},
+				searchMove: func(
+					storage models.PieceStorage,
+					color models.Color,
+					deep int,
+					bounds moves.Bounds,
+				) (moves.ScoredMove, error) {
+					if terminators.IsIterationTerminated(terminator, 1) {
+						test.Fail()
+					}
+
+					move := moves.ScoredMove{Score: float64(index)}
+					terminators.CompleteIteration(
+						terminator,
+						1,
+						moves.FailedMove{Move: move},
+					)
+
+					return move, nil
+				},
+			}
+		},
+		nil,
+	)
+	_, gotErr := searcher.SearchMove(
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	if gotErr != nil {
+		test.Fail()
+	}
+	// only a main worker completes iterations
+	wantCompletedMoves := []moves.FailedMove{
+		{Move: moves.ScoredMove{Score: 0}},
+	}
+	if !reflect.DeepEqual(completedMoves, wantCompletedMoves) {
+		test.Fail()
+	}
+}
+
+func TestParallelSearcherSearchMove_withSelector(test *testing.T) {
+	type data struct {
+		selector ResultSelector
+		wantMove moves.ScoredMove
+	}
+
+	moveOne := models.Move{
+		Start:  models.Position{File: 1, Rank: 2},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+	moveTwo := models.Move{
+		Start:  models.Position{File: 5, Rank: 6},
+		Finish: models.Position{File: 7, Rank: 8},
+	}
+	for _, data := range []data{
+		{
+			selector: SelectDeepestResult,
+			wantMove: moves.ScoredMove{Move: moveTwo, Score: 1},
+		},
+		{
+			selector: SelectVotedResult,
+			wantMove: moves.ScoredMove{Move: moveOne, Score: 2},
+		},
+	} {
+		// workers produce the same set of results in any order
+		results := make(chan WorkerResult, 3)
+		results <- WorkerResult{
+			FailedMove: moves.FailedMove{Move: moves.ScoredMove{Move: moveOne, Score: 2}},
+			Deep:       1,
+		}
+		results <- WorkerResult{
+			FailedMove: moves.FailedMove{Move: moves.ScoredMove{Move: moveOne, Score: 2}},
+			Deep:       2,
+		}
+		results <- WorkerResult{
+			FailedMove: moves.FailedMove{Move: moves.ScoredMove{Move: moveTwo, Score: 1}},
+			Deep:       3,
+		}
+
+		searcher := NewParallelSearcher(
+			MockSearchTerminator{},
+			3,
+			func(index int) MoveSearcher {
+				result := <-results
+				return MockDeepSearcher{
+					MockMoveSearcher: MockMoveSearcher{
+						setTerminator: func(terminator terminators.SearchTerminator) {},
+						searchMove: func(
+							storage models.PieceStorage,
+							color models.Color,
+							deep int,
+							bounds moves.Bounds,
+						) (moves.ScoredMove, error) {
+							return result.Move, result.Error
+						},
+					},
+					completedDeep: result.Deep,
+				}
+			},
+			data.selector,
+		)
+
+		gotMove, gotErr := searcher.SearchMove(
+			MockPieceStorage{},
+			models.White,
+			0,
+			moves.NewBounds(),
+		)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+}
+
+// goroutines may still be exiting after they've reported finishing,
+// so their count is polled
+func waitGoroutineCount(maximalCount int) bool {
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= maximalCount {
+			return true
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return false
+}
+
+func TestParallelSearcherSearchMove_withObserver(test *testing.T) {
+	observer := MockSearchObserver{events: new([]string)}
+
+	var observerLock sync.Mutex
+	observedIndices := make(map[int]observers.SearchObserver)
+	searcher := NewParallelSearcher(
+		MockSearchTerminator{},
+		10,
+		func(index int) MoveSearcher {
+			return MockObservableSearcher{
+				MockMoveSearcher: MockMoveSearcher{
+					setTerminator: func(terminator terminators.SearchTerminator) {},
+					searchMove: func(
+						storage models.PieceStorage,
+						color models.Color,
+						deep int,
+						bounds moves.Bounds,
+					) (moves.ScoredMove, error) {
+						return moves.ScoredMove{}, nil
+					},
+				},
+				setObserver: func(observer observers.SearchObserver) {
+					observerLock.Lock()
+					defer observerLock.Unlock()
+
+					observedIndices[index] = observer
+				},
+			}
+		},
+		nil,
+	)
+	searcher.SetObserver(observer)
+	searcher.SearchMove( // nolint: errcheck
+		MockPieceStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	wantObservedIndices := map[int]observers.SearchObserver{0: observer}
+	if !reflect.DeepEqual(observedIndices, wantObservedIndices) {
+		test.Fail()
 	}
 }
@@ -0,0 +1,60 @@
+package chessminimax
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// PausableSearcher ...
+//
+// It wraps the terminator of the inner searcher into PausableTerminator,
+// so a running search can be paused and resumed via embedded methods
+// of terminators.Pauser from another goroutine. While a search is paused,
+// the inner searcher is blocked without losing its state.
+type PausableSearcher struct {
+	*SearcherSetter
+	*TerminatorSetter
+	*terminators.Pauser
+}
+
+// NewPausableSearcher ...
+func NewPausableSearcher(
+	innerSearcher MoveSearcher,
+	terminator terminators.SearchTerminator,
+) PausableSearcher {
+	searcher := PausableSearcher{
+		SearcherSetter:   new(SearcherSetter),
+		TerminatorSetter: new(TerminatorSetter),
+		Pauser:           new(terminators.Pauser),
+	}
+
+	searcher.SetSearcher(innerSearcher)
+	searcher.SetTerminator(terminator)
+
+	return searcher
+}
+
+// SetTerminator ...
+//
+// It also sets the terminator wrapped into PausableTerminator
+// to the inner searcher.
+func (searcher PausableSearcher) SetTerminator(
+	terminator terminators.SearchTerminator,
+) {
+	searcher.TerminatorSetter.SetTerminator(terminator)
+
+	pausableTerminator :=
+		terminators.NewPausableTerminator(searcher.Pauser, terminator)
+	searcher.searcher.SetTerminator(pausableTerminator)
+}
+
+// SearchMove ...
+func (searcher PausableSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	return searcher.searcher.SearchMove(storage, color, deep, bounds)
+}
@@ -0,0 +1,120 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewPausableSearcher(test *testing.T) {
+	var terminator MockSearchTerminator
+	var innerTerminator terminators.SearchTerminator
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {
+			innerTerminator = terminator
+		},
+	}
+	searcher := NewPausableSearcher(innerSearcher, terminator)
+
+	if _, ok := searcher.searcher.(MockMoveSearcher); !ok {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.terminator, terminator) {
+		test.Fail()
+	}
+	if searcher.Pauser == nil {
+		test.Fail()
+	}
+
+	wantInnerTerminator :=
+		terminators.NewPausableTerminator(searcher.Pauser, terminator)
+	if !reflect.DeepEqual(innerTerminator, wantInnerTerminator) {
+		test.Fail()
+	}
+}
+
+func TestPausableSearcherSearchMove(test *testing.T) {
+	var innerTerminator terminators.SearchTerminator
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {
+			innerTerminator = terminator
+		},
+		searchMove: func(
+			storage models.PieceStorage,
+			color models.Color,
+			deep int,
+			bounds moves.Bounds,
+		) (moves.ScoredMove, error) {
+			if _, ok := storage.(MockPieceStorage); !ok {
+				test.Fail()
+			}
+			if color != models.White {
+				test.Fail()
+			}
+			if deep != 2 {
+				test.Fail()
+			}
+			if !reflect.DeepEqual(bounds, moves.NewBounds()) {
+				test.Fail()
+			}
+
+			// it blocks while the search is paused
+			if innerTerminator.IsSearchTerminated(deep) {
+				test.Fail()
+			}
+
+			move := models.Move{
+				Start:  models.Position{File: 1, Rank: 2},
+				Finish: models.Position{File: 3, Rank: 4},
+			}
+			return moves.ScoredMove{Move: move, Score: 2.3}, nil
+		},
+	}
+	terminator := MockSearchTerminator{
+		isSearchTerminated: func(deep int) bool { return false },
+	}
+	searcher := NewPausableSearcher(innerSearcher, terminator)
+	searcher.Pause()
+
+	type result struct {
+		move moves.ScoredMove
+		err  error
+	}
+	results := make(chan result)
+	go func() {
+		move, err := searcher.SearchMove(
+			MockPieceStorage{},
+			models.White,
+			2,
+			moves.NewBounds(),
+		)
+		results <- result{move, err}
+	}()
+
+	select {
+	case <-results:
+		test.Fail() // it should block while paused
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	searcher.Resume()
+	got := <-results
+
+	wantMove := moves.ScoredMove{
+		Move: models.Move{
+			Start:  models.Position{File: 1, Rank: 2},
+			Finish: models.Position{File: 3, Rank: 4},
+		},
+		Score: 2.3,
+	}
+	if !reflect.DeepEqual(got.move, wantMove) {
+		test.Fail()
+	}
+	if got.err != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,84 @@
+package pgn
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// nolint: gochecknoglobals
+var annotationPattern = regexp.MustCompile(
+	`^([+-]?)(M?)(\d+(?:\.\d+)?)/(\d+)(?:\s|$)`,
+)
+
+// Annotation ...
+//
+// It's an engine annotation of a move. It's written as a comment in a format
+// "score/deep" (e.g. "+0.35/12" or "-M3/10"), where a score is measured
+// in pawns from the point of view of a side, which makes a move.
+type Annotation struct {
+	Score moves.Score
+	Deep  int
+}
+
+// NewAnnotation ...
+//
+// It converts a raw score of a move relative to a root of a search.
+func NewAnnotation(move moves.ScoredMove, deep int) Annotation {
+	return Annotation{Score: moves.ParseScore(move.Score), Deep: deep}
+}
+
+// ParseAnnotation ...
+//
+// It parses an annotation from a start of a comment, so the comment may
+// contain other text after the annotation.
+func ParseAnnotation(comment string) (Annotation, bool) {
+	match := annotationPattern.FindStringSubmatch(comment)
+	if match == nil {
+		return Annotation{}, false
+	}
+
+	deep, err := strconv.Atoi(match[4])
+	if err != nil {
+		return Annotation{}, false
+	}
+
+	var score moves.Score
+	if match[2] == "M" {
+		mate, err := strconv.Atoi(match[3])
+		if err != nil {
+			return Annotation{}, false
+		}
+		if match[1] == "-" {
+			mate = -mate
+		}
+
+		score = moves.NewMateScore(mate)
+	} else {
+		value, err := strconv.ParseFloat(match[1]+match[3], 64)
+		if err != nil {
+			return Annotation{}, false
+		}
+
+		score = moves.NewEvaluationScore(value)
+	}
+
+	return Annotation{Score: score, Deep: deep}, true
+}
+
+// String ...
+func (annotation Annotation) String() string {
+	var score string
+	switch {
+	case annotation.Score.Kind != moves.MateScore:
+		score = fmt.Sprintf("%+.2f", annotation.Score.Value)
+	case annotation.Score.Mate > 0:
+		score = fmt.Sprintf("+M%d", annotation.Score.Mate)
+	default:
+		score = fmt.Sprintf("-M%d", -annotation.Score.Mate)
+	}
+
+	return fmt.Sprintf("%s/%d", score, annotation.Deep)
+}
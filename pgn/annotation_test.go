@@ -0,0 +1,127 @@
+package pgn
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+func TestNewAnnotation(test *testing.T) {
+	type args struct {
+		move moves.ScoredMove
+		deep int
+	}
+	type data struct {
+		args args
+		want Annotation
+	}
+
+	for _, data := range []data{
+		{
+			args: args{move: moves.ScoredMove{Score: 0.35}, deep: 12},
+			want: Annotation{Score: moves.NewEvaluationScore(0.35), Deep: 12},
+		},
+		{
+			args: args{
+				move: moves.ScoredMove{Score: -moves.NewCheckmateScore(5)},
+				deep: 6,
+			},
+			want: Annotation{Score: moves.NewMateScore(3), Deep: 6},
+		},
+	} {
+		got := NewAnnotation(data.args.move, data.args.deep)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestParseAnnotation(test *testing.T) {
+	type data struct {
+		comment        string
+		wantAnnotation Annotation
+		wantOk         bool
+	}
+
+	for _, data := range []data{
+		{
+			comment: "+0.35/12",
+			wantAnnotation: Annotation{
+				Score: moves.NewEvaluationScore(0.35),
+				Deep:  12,
+			},
+			wantOk: true,
+		},
+		{
+			comment: "-1/3 1.5s",
+			wantAnnotation: Annotation{
+				Score: moves.NewEvaluationScore(-1),
+				Deep:  3,
+			},
+			wantOk: true,
+		},
+		{
+			comment:        "+M3/6",
+			wantAnnotation: Annotation{Score: moves.NewMateScore(3), Deep: 6},
+			wantOk:         true,
+		},
+		{
+			comment:        "-M2/5",
+			wantAnnotation: Annotation{Score: moves.NewMateScore(-2), Deep: 5},
+			wantOk:         true,
+		},
+		{
+			comment:        "a comment",
+			wantAnnotation: Annotation{},
+			wantOk:         false,
+		},
+		{
+			comment:        "+0.35/12s",
+			wantAnnotation: Annotation{},
+			wantOk:         false,
+		},
+	} {
+		gotAnnotation, gotOk := ParseAnnotation(data.comment)
+
+		if !reflect.DeepEqual(gotAnnotation, data.wantAnnotation) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestAnnotationString(test *testing.T) {
+	type data struct {
+		annotation Annotation
+		want       string
+	}
+
+	for _, data := range []data{
+		{
+			annotation: Annotation{Score: moves.NewEvaluationScore(0.35), Deep: 12},
+			want:       "+0.35/12",
+		},
+		{
+			annotation: Annotation{Score: moves.NewEvaluationScore(-1), Deep: 3},
+			want:       "-1.00/3",
+		},
+		{
+			annotation: Annotation{Score: moves.NewMateScore(3), Deep: 6},
+			want:       "+M3/6",
+		},
+		{
+			annotation: Annotation{Score: moves.NewMateScore(-2), Deep: 5},
+			want:       "-M2/5",
+		},
+	} {
+		got := data.annotation.String()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
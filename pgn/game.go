@@ -0,0 +1,171 @@
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// ...
+const (
+	WhiteWin      = "1-0"
+	BlackWin      = "0-1"
+	Draw          = "1/2-1/2"
+	UnknownResult = "*"
+)
+
+// Tag ...
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// Move ...
+//
+// It's a move in the Standard Algebraic Notation (SAN) with its annotations.
+type Move struct {
+	SAN  string
+	NAGs []int
+	// comments after a move
+	Comments []string
+	// alternatives to a move
+	Variations []Variation
+}
+
+// Variation ...
+type Variation struct {
+	// comments before a first move
+	Comments []string
+	Moves    []Move
+}
+
+// Game ...
+type Game struct {
+	Tags []Tag
+	// comments before a first move
+	Comments []string
+	// a main line
+	Moves  []Move
+	Result string
+}
+
+// MoveError ...
+//
+// It describes a move of a game, which can't be decoded.
+type MoveError struct {
+	// it's a zero-based index of a move in a game
+	Ply  int
+	Move string
+	Err  error
+}
+
+// Tag ...
+//
+// It returns a value of a first tag with the specified name.
+func (game Game) Tag(name string) (value string, ok bool) {
+	for _, tag := range game.Tags {
+		if tag.Name == name {
+			return tag.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// InitialPosition ...
+//
+// It decodes a position from the FEN tag, if it's present; otherwise,
+// it returns the initial position of a game. Only a board and an active color
+// of the FEN tag are used.
+func (game Game) InitialPosition() (models.PieceStorage, models.Color, error) {
+	fen, ok := game.Tag("FEN")
+	if !ok {
+		fen = moves.InitialBoardInFEN
+	}
+
+	fields := strings.Fields(fen)
+	if len(fields) == 0 {
+		return nil, 0, errors.New("unable to decode the FEN tag: empty value")
+	}
+
+	storage, err :=
+		uci.DecodePieceStorage(fields[0], pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to decode the FEN tag: %v", err)
+	}
+
+	color := models.White
+	if len(fields) > 1 && fields[1] == "b" {
+		color = models.Black
+	}
+
+	return storage, color, nil
+}
+
+// it returns a number of a first move from the FEN tag, if it's present
+func (game Game) initialMoveNumber() int {
+	fen, ok := game.Tag("FEN")
+	if !ok {
+		return 1
+	}
+
+	fields := strings.Fields(fen)
+	if len(fields) < 6 {
+		return 1
+	}
+
+	number, err := strconv.Atoi(fields[5])
+	if err != nil || number < 1 {
+		return 1
+	}
+
+	return number
+}
+
+// DecodeMoves ...
+//
+// It replays moves of a main line from an initial position of the game.
+// On a first move, which can't be decoded, it returns moves decoded before it
+// and MoveError.
+func (game Game) DecodeMoves() (
+	storage models.PieceStorage,
+	color models.Color,
+	moves []models.Move,
+	err error,
+) {
+	storage, color, err = game.InitialPosition()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	currentStorage, currentColor := storage, color
+	for ply, gameMove := range game.Moves {
+		move, err := DecodeMove(currentStorage, currentColor, gameMove.SAN)
+		if err != nil {
+			err = MoveError{Ply: ply, Move: gameMove.SAN, Err: err}
+			return storage, color, moves, err
+		}
+
+		moves = append(moves, move)
+		currentStorage = currentStorage.ApplyMove(move)
+		currentColor = currentColor.Negative()
+	}
+
+	return storage, color, moves, nil
+}
+
+// Error ...
+func (err MoveError) Error() string {
+	return fmt.Sprintf(
+		"unable to decode the move #%d %q: %v",
+		err.Ply+1,
+		err.Move,
+		err.Err,
+	)
+}
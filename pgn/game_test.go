@@ -0,0 +1,167 @@
+package pgn
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestGameTag(test *testing.T) {
+	game := Game{
+		Tags: []Tag{
+			{Name: "Event", Value: "First"},
+			{Name: "Event", Value: "Second"},
+		},
+	}
+
+	gotValue, gotOk := game.Tag("Event")
+	if gotValue != "First" || !gotOk {
+		test.Fail()
+	}
+
+	gotValue, gotOk = game.Tag("Site")
+	if gotValue != "" || gotOk {
+		test.Fail()
+	}
+}
+
+func TestGameInitialPosition(test *testing.T) {
+	type data struct {
+		game      Game
+		wantBoard string
+		wantColor models.Color
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			game:      Game{},
+			wantBoard: moves.InitialBoardInFEN,
+			wantColor: models.White,
+			wantErr:   false,
+		},
+		{
+			game: Game{
+				Tags: []Tag{
+					{Name: "FEN", Value: "4k3/8/8/8/8/8/8/4K3 b - - 0 1"},
+				},
+			},
+			wantBoard: "4k3/8/8/8/8/8/8/4K3",
+			wantColor: models.Black,
+			wantErr:   false,
+		},
+		{
+			game:    Game{Tags: []Tag{{Name: "FEN", Value: ""}}},
+			wantErr: true,
+		},
+		{
+			game:    Game{Tags: []Tag{{Name: "FEN", Value: "invalid w"}}},
+			wantErr: true,
+		},
+	} {
+		gotStorage, gotColor, gotErr := data.game.InitialPosition()
+
+		if data.wantErr {
+			if gotErr == nil {
+				test.Fail()
+			}
+
+			continue
+		}
+		if gotErr != nil {
+			test.Fail()
+			continue
+		}
+
+		wantStorage, err := uci.DecodePieceStorage(
+			data.wantBoard,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotStorage, wantStorage) {
+			test.Fail()
+		}
+		if gotColor != data.wantColor {
+			test.Fail()
+		}
+	}
+}
+
+func TestGameDecodeMoves(test *testing.T) {
+	type data struct {
+		game      Game
+		wantMoves []models.Move
+		wantErr   error
+	}
+
+	for _, data := range []data{
+		{
+			game: Game{Moves: []Move{{SAN: "e4"}, {SAN: "e5"}, {SAN: "Nf3"}}},
+			wantMoves: []models.Move{
+				{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 4, Rank: 3},
+				},
+				{
+					Start:  models.Position{File: 4, Rank: 6},
+					Finish: models.Position{File: 4, Rank: 4},
+				},
+				{
+					Start:  models.Position{File: 6, Rank: 0},
+					Finish: models.Position{File: 5, Rank: 2},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			game: Game{Moves: []Move{{SAN: "e4"}, {SAN: "e4"}, {SAN: "Nf3"}}},
+			wantMoves: []models.Move{
+				{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 4, Rank: 3},
+				},
+			},
+			wantErr: MoveError{Ply: 1, Move: "e4", Err: ErrIllegalMove},
+		},
+	} {
+		gotStorage, gotColor, gotMoves, gotErr := data.game.DecodeMoves()
+
+		wantStorage, err := uci.DecodePieceStorage(
+			moves.InitialBoardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotStorage, wantStorage) {
+			test.Fail()
+		}
+		if gotColor != models.White {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotErr, data.wantErr) {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveErrorError(test *testing.T) {
+	err := MoveError{Ply: 1, Move: "O-O", Err: ErrUnsupportedMove}
+	got := err.Error()
+
+	want := `unable to decode the move #2 "O-O": unsupported move`
+	if got != want {
+		test.Fail()
+	}
+}
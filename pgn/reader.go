@@ -0,0 +1,367 @@
+package pgn
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ...
+var (
+	ErrInvalidTag       = errors.New("invalid tag")
+	ErrInvalidNAG       = errors.New("invalid NAG")
+	ErrInvalidVariation = errors.New("invalid variation")
+)
+
+// it maps suffix annotations of moves to equivalent NAGs
+// nolint: gochecknoglobals
+var suffixNAGs = map[string]int{
+	"!":  1,
+	"?":  2,
+	"!!": 3,
+	"??": 4,
+	"!?": 5,
+	"?!": 6,
+}
+
+type tokenKind int
+
+const (
+	tagToken tokenKind = iota
+	moveNumberToken
+	moveToken
+	commentToken
+	nagToken
+	variationStartToken
+	variationEndToken
+	resultToken
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value string // it's used only by tags
+}
+
+// Reader ...
+//
+// It reads games in the PGN format one by one, so it's suitable
+// for large archives.
+type Reader struct {
+	reader    *bufio.Reader
+	nextToken *token
+	// it's true, if a next symbol starts a line
+	isLineStart bool
+}
+
+// NewReader ...
+func NewReader(reader io.Reader) *Reader {
+	return &Reader{reader: bufio.NewReader(reader), isLineStart: true}
+}
+
+// Read ...
+//
+// It reads a next game with its comments, NAGs and variations. It returns
+// io.EOF, if there isn't a next game.
+func (reader *Reader) Read() (Game, error) {
+	var game Game
+	for {
+		currentToken, err := reader.readToken()
+		if err == io.EOF && len(game.Tags) != 0 {
+			// a game may be without movetext
+			return game, nil
+		}
+		if err != nil {
+			return Game{}, err
+		}
+
+		if currentToken.kind != tagToken {
+			reader.nextToken = &currentToken
+			break
+		}
+
+		tag := Tag{Name: currentToken.text, Value: currentToken.value}
+		game.Tags = append(game.Tags, tag)
+	}
+
+	line, result, err := reader.readLine(false)
+	if err != nil {
+		return Game{}, err
+	}
+
+	game.Comments, game.Moves, game.Result = line.Comments, line.Moves, result
+	return game, nil
+}
+
+// ReadAll ...
+func (reader *Reader) ReadAll() ([]Game, error) {
+	var games []Game
+	for {
+		game, err := reader.Read()
+		if err == io.EOF {
+			return games, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		games = append(games, game)
+	}
+}
+
+// it reads moves until an end of a variation, a result or a next game
+func (reader *Reader) readLine(isVariation bool) (
+	line Variation,
+	result string,
+	err error,
+) {
+	for {
+		currentToken, err := reader.readToken()
+		if err == io.EOF && !isVariation {
+			// a last game may be without a result
+			return line, "", nil
+		}
+		if err == io.EOF {
+			return Variation{}, "", ErrInvalidVariation
+		}
+		if err != nil {
+			return Variation{}, "", err
+		}
+
+		var lastMove *Move
+		if len(line.Moves) != 0 {
+			lastMove = &line.Moves[len(line.Moves)-1]
+		}
+
+		switch currentToken.kind {
+		case tagToken:
+			if isVariation {
+				return Variation{}, "", ErrInvalidVariation
+			}
+
+			// it's a start of a next game
+			reader.nextToken = &currentToken
+			return line, "", nil
+		case moveToken:
+			// a suffix annotation may be also separated from a move, e.g. "e4 !"
+			san := strings.TrimRight(currentToken.text, "!?")
+			if san != "" {
+				line.Moves = append(line.Moves, Move{SAN: san})
+				lastMove = &line.Moves[len(line.Moves)-1]
+			}
+
+			if suffix := currentToken.text[len(san):]; suffix != "" {
+				nag, ok := suffixNAGs[suffix]
+				if !ok || lastMove == nil {
+					return Variation{}, "", ErrInvalidNAG
+				}
+
+				lastMove.NAGs = append(lastMove.NAGs, nag)
+			}
+		case commentToken:
+			if lastMove == nil {
+				line.Comments = append(line.Comments, currentToken.text)
+				break
+			}
+
+			lastMove.Comments = append(lastMove.Comments, currentToken.text)
+		case nagToken:
+			nag, err := strconv.Atoi(currentToken.text[1:])
+			if err != nil || lastMove == nil {
+				return Variation{}, "", ErrInvalidNAG
+			}
+
+			lastMove.NAGs = append(lastMove.NAGs, nag)
+		case variationStartToken:
+			if lastMove == nil {
+				return Variation{}, "", ErrInvalidVariation
+			}
+
+			variation, _, err := reader.readLine(true)
+			if err != nil {
+				return Variation{}, "", err
+			}
+
+			lastMove.Variations = append(lastMove.Variations, variation)
+		case variationEndToken:
+			if !isVariation {
+				return Variation{}, "", ErrInvalidVariation
+			}
+
+			return line, "", nil
+		case resultToken:
+			// a result inside a variation is ignored
+			if !isVariation {
+				return line, currentToken.text, nil
+			}
+		}
+	}
+}
+
+func (reader *Reader) readToken() (token, error) {
+	if reader.nextToken != nil {
+		nextToken := *reader.nextToken
+		reader.nextToken = nil
+
+		return nextToken, nil
+	}
+
+	for {
+		isLineStart := reader.isLineStart
+		symbol, err := reader.readRune()
+		if err != nil {
+			return token{}, err
+		}
+
+		switch {
+		case symbol == '%' && isLineStart:
+			// it's an escape mechanism, so a line should be skipped
+			if _, err := reader.readUntil('\n'); err != nil {
+				return token{}, err
+			}
+		case unicode.IsSpace(symbol):
+		case symbol == '[':
+			return reader.readTag()
+		case symbol == '{':
+			text, err := reader.readUntil('}')
+			if err != nil {
+				return token{}, err
+			}
+
+			// a comment may be wrapped on writing, so line breaks inside it
+			// are equivalent to spaces
+			text = strings.Join(strings.Fields(text), " ")
+			return token{kind: commentToken, text: text}, nil
+		case symbol == ';':
+			text, err := reader.readUntil('\n')
+			if err != nil && err != io.EOF {
+				return token{}, err
+			}
+
+			return token{kind: commentToken, text: strings.TrimSpace(text)}, nil
+		case symbol == '(':
+			return token{kind: variationStartToken, text: "("}, nil
+		case symbol == ')':
+			return token{kind: variationEndToken, text: ")"}, nil
+		default:
+			return reader.readSymbol(symbol)
+		}
+	}
+}
+
+func (reader *Reader) readTag() (token, error) {
+	// a closing bracket inside a quoted value doesn't end a tag
+	var symbols []rune
+	var isQuoted, isEscaped bool
+	for {
+		symbol, err := reader.readRune()
+		if err != nil {
+			return token{}, err
+		}
+		if symbol == ']' && !isQuoted {
+			break
+		}
+
+		symbols = append(symbols, symbol)
+		switch {
+		case isEscaped:
+			isEscaped = false
+		case symbol == '\\' && isQuoted:
+			isEscaped = true
+		case symbol == '"':
+			isQuoted = !isQuoted
+		}
+	}
+
+	text := strings.TrimSpace(string(symbols))
+	nameEnd := strings.IndexFunc(text, unicode.IsSpace)
+	if nameEnd == -1 {
+		return token{}, ErrInvalidTag
+	}
+
+	name, value := text[:nameEnd], strings.TrimSpace(text[nameEnd:])
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return token{}, ErrInvalidTag
+	}
+
+	value = value[1 : len(value)-1]
+	value = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(value)
+	return token{kind: tagToken, text: name, value: value}, nil
+}
+
+func (reader *Reader) readSymbol(firstSymbol rune) (token, error) {
+	symbols := []rune{firstSymbol}
+	for {
+		symbol, err := reader.readRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return token{}, err
+		}
+
+		if unicode.IsSpace(symbol) || strings.ContainsRune("[]{}();", symbol) {
+			if err := reader.unreadRune(); err != nil {
+				return token{}, err
+			}
+
+			break
+		}
+
+		symbols = append(symbols, symbol)
+	}
+
+	text := string(symbols)
+	switch {
+	case text[0] == '$':
+		return token{kind: nagToken, text: text}, nil
+	case text == "1-0" || text == "0-1" || text == "1/2-1/2" || text == "*":
+		return token{kind: resultToken, text: text}, nil
+	}
+
+	// a move number may be written together with a move, e.g. "1.e4"
+	move := strings.TrimLeft(text, "0123456789")
+	if move != text && strings.HasPrefix(move, ".") {
+		move = strings.TrimLeft(move, ".")
+		if move == "" {
+			return token{kind: moveNumberToken, text: text}, nil
+		}
+	} else {
+		move = text
+	}
+
+	return token{kind: moveToken, text: move}, nil
+}
+
+func (reader *Reader) readUntil(delimiter rune) (string, error) {
+	var symbols []rune
+	for {
+		symbol, err := reader.readRune()
+		if err != nil {
+			return string(symbols), err
+		}
+		if symbol == delimiter {
+			return string(symbols), nil
+		}
+
+		symbols = append(symbols, symbol)
+	}
+}
+
+func (reader *Reader) readRune() (rune, error) {
+	symbol, _, err := reader.reader.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+
+	reader.isLineStart = symbol == '\n'
+	return symbol, nil
+}
+
+func (reader *Reader) unreadRune() error {
+	// an unread symbol will be read again, so a line start will be restored
+	return reader.reader.UnreadRune()
+}
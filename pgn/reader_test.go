@@ -0,0 +1,268 @@
+package pgn
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReaderRead(test *testing.T) {
+	type data struct {
+		text     string
+		wantGame Game
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			text: `[Event "Test \"game\""]
+[Result "1-0"]
+
+1. e4 e5 2. Nf3 1-0`,
+			wantGame: Game{
+				Tags: []Tag{
+					{Name: "Event", Value: `Test "game"`},
+					{Name: "Result", Value: "1-0"},
+				},
+				Moves:  []Move{{SAN: "e4"}, {SAN: "e5"}, {SAN: "Nf3"}},
+				Result: WhiteWin,
+			},
+			wantErr: nil,
+		},
+		// comments, NAGs, variations and escaped lines
+		{
+			text: `% an escaped line
+{a game comment} 1.e4 {a comment} e5 $1 $10 (1... c5 (1... e6) 2. Nf3)
+({a variation comment} 1... d5) 2. Nf3! ; a comment
+2... Nc6 *`,
+			wantGame: Game{
+				Comments: []string{"a game comment"},
+				Moves: []Move{
+					{SAN: "e4", Comments: []string{"a comment"}},
+					{
+						SAN:  "e5",
+						NAGs: []int{1, 10},
+						Variations: []Variation{
+							{
+								Moves: []Move{
+									{
+										SAN: "c5",
+										Variations: []Variation{
+											{Moves: []Move{{SAN: "e6"}}},
+										},
+									},
+									{SAN: "Nf3"},
+								},
+							},
+							{
+								Comments: []string{"a variation comment"},
+								Moves:    []Move{{SAN: "d5"}},
+							},
+						},
+					},
+					{SAN: "Nf3", NAGs: []int{1}, Comments: []string{"a comment"}},
+					{SAN: "Nc6"},
+				},
+				Result: UnknownResult,
+			},
+			wantErr: nil,
+		},
+		// a result inside a variation
+		{
+			text: "1. e4 (1. d4 1-0) e5 0-1",
+			wantGame: Game{
+				Moves: []Move{
+					{
+						SAN: "e4",
+						Variations: []Variation{
+							{Moves: []Move{{SAN: "d4"}}},
+						},
+					},
+					{SAN: "e5"},
+				},
+				Result: BlackWin,
+			},
+			wantErr: nil,
+		},
+		// suffix annotations
+		{
+			text: "1. e4!! e5?? 2. Nf3!? Nc6?! 3. Bb5 ! a6 ? *",
+			wantGame: Game{
+				Moves: []Move{
+					{SAN: "e4", NAGs: []int{3}},
+					{SAN: "e5", NAGs: []int{4}},
+					{SAN: "Nf3", NAGs: []int{5}},
+					{SAN: "Nc6", NAGs: []int{6}},
+					{SAN: "Bb5", NAGs: []int{1}},
+					{SAN: "a6", NAGs: []int{2}},
+				},
+				Result: UnknownResult,
+			},
+			wantErr: nil,
+		},
+		// brackets inside a tag value
+		{
+			text: `[Event "A [B] C"]
+[Site "D \"]"]
+
+1. e4 *`,
+			wantGame: Game{
+				Tags: []Tag{
+					{Name: "Event", Value: "A [B] C"},
+					{Name: "Site", Value: `D "]`},
+				},
+				Moves:  []Move{{SAN: "e4"}},
+				Result: UnknownResult,
+			},
+			wantErr: nil,
+		},
+		// a comment wrapped on several lines
+		{
+			text: "1. e4 {a long\n  comment} *",
+			wantGame: Game{
+				Moves:  []Move{{SAN: "e4", Comments: []string{"a long comment"}}},
+				Result: UnknownResult,
+			},
+			wantErr: nil,
+		},
+		// a game without a result
+		{
+			text: "1. e4 e5",
+			wantGame: Game{
+				Moves: []Move{{SAN: "e4"}, {SAN: "e5"}},
+			},
+			wantErr: nil,
+		},
+		// a game without movetext
+		{
+			text: `[Event "Test"]`,
+			wantGame: Game{
+				Tags: []Tag{{Name: "Event", Value: "Test"}},
+			},
+			wantErr: nil,
+		},
+		{
+			text:     "",
+			wantGame: Game{},
+			wantErr:  io.EOF,
+		},
+		{
+			text:     `[Event] 1. e4 *`,
+			wantGame: Game{},
+			wantErr:  ErrInvalidTag,
+		},
+		{
+			text:     "$1 1. e4 *",
+			wantGame: Game{},
+			wantErr:  ErrInvalidNAG,
+		},
+		{
+			text:     "1. e4!!! *",
+			wantGame: Game{},
+			wantErr:  ErrInvalidNAG,
+		},
+		{
+			text:     "! 1. e4 *",
+			wantGame: Game{},
+			wantErr:  ErrInvalidNAG,
+		},
+		{
+			text:     "1. e4 $x *",
+			wantGame: Game{},
+			wantErr:  ErrInvalidNAG,
+		},
+		{
+			text:     "(1. d4) 1. e4 *",
+			wantGame: Game{},
+			wantErr:  ErrInvalidVariation,
+		},
+		{
+			text:     "1. e4 ) e5 *",
+			wantGame: Game{},
+			wantErr:  ErrInvalidVariation,
+		},
+		{
+			text:     "1. e4 (1. d4",
+			wantGame: Game{},
+			wantErr:  ErrInvalidVariation,
+		},
+		{
+			text:     `1. e4 (1. d4 [Event "Test"]) *`,
+			wantGame: Game{},
+			wantErr:  ErrInvalidVariation,
+		},
+	} {
+		reader := NewReader(strings.NewReader(data.text))
+		gotGame, gotErr := reader.Read()
+
+		if !reflect.DeepEqual(gotGame, data.wantGame) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestReaderReadAll(test *testing.T) {
+	type data struct {
+		text      string
+		wantGames []Game
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			text: `[Event "First"]
+
+1. e4 e5 1-0
+
+[Event "Second"]
+
+1. d4 d5
+
+[Event "Third"]
+
+1. c4 1/2-1/2
+`,
+			wantGames: []Game{
+				{
+					Tags:   []Tag{{Name: "Event", Value: "First"}},
+					Moves:  []Move{{SAN: "e4"}, {SAN: "e5"}},
+					Result: WhiteWin,
+				},
+				{
+					Tags:  []Tag{{Name: "Event", Value: "Second"}},
+					Moves: []Move{{SAN: "d4"}, {SAN: "d5"}},
+				},
+				{
+					Tags:   []Tag{{Name: "Event", Value: "Third"}},
+					Moves:  []Move{{SAN: "c4"}},
+					Result: Draw,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			text:      "",
+			wantGames: nil,
+			wantErr:   false,
+		},
+		{
+			text:      "1. e4 e5 1-0 1. d4 ) *",
+			wantGames: nil,
+			wantErr:   true,
+		},
+	} {
+		reader := NewReader(strings.NewReader(data.text))
+		gotGames, gotErr := reader.ReadAll()
+
+		if !reflect.DeepEqual(gotGames, data.wantGames) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
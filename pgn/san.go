@@ -0,0 +1,284 @@
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// ...
+var (
+	ErrInvalidMove     = errors.New("invalid move")
+	ErrUnsupportedMove = errors.New("unsupported move")
+	ErrIllegalMove     = errors.New("illegal move")
+	ErrAmbiguousMove   = errors.New("ambiguous move")
+)
+
+// nolint: gochecknoglobals
+var pieceKinds = map[byte]models.Kind{
+	'K': models.King,
+	'Q': models.Queen,
+	'R': models.Rook,
+	'B': models.Bishop,
+	'N': models.Knight,
+}
+
+type sanMove struct {
+	kind      models.Kind
+	finish    models.Position
+	startFile int // it's -1, if it isn't specified
+	startRank int // it's -1, if it isn't specified
+}
+
+// DecodeMove ...
+//
+// It decodes a move in the Standard Algebraic Notation (SAN) by legal moves
+// of a current side. Check, checkmate and annotation suffixes are ignored.
+//
+// Piece storages don't support castling, en passant and promotions, so
+// castling and promotions are decoded with ErrUnsupportedMove, and en passant
+// is decoded with ErrIllegalMove.
+func DecodeMove(
+	storage models.PieceStorage,
+	color models.Color,
+	text string,
+) (models.Move, error) {
+	move, err := parseSAN(text)
+	if err != nil {
+		return models.Move{}, err
+	}
+
+	legalMoves, err := moves.LegalMoves(models.MoveGenerator{}, storage, color)
+	if err != nil {
+		return models.Move{}, err
+	}
+
+	var candidates []models.Move
+	for _, candidate := range legalMoves {
+		if move.isMatched(storage, candidate) {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return models.Move{}, ErrIllegalMove
+	case 1:
+		return candidates[0], nil
+	default:
+		return models.Move{}, ErrAmbiguousMove
+	}
+}
+
+// EncodeMove ...
+//
+// It encodes a legal move of a current side in the Standard Algebraic
+// Notation (SAN) with a minimal disambiguation and a check or checkmate
+// suffix.
+func EncodeMove(
+	storage models.PieceStorage,
+	color models.Color,
+	move models.Move,
+) (string, error) {
+	legalMoves, err := moves.LegalMoves(models.MoveGenerator{}, storage, color)
+	if err != nil {
+		return "", err
+	}
+
+	if !containsMove(legalMoves, move) {
+		return "", ErrIllegalMove
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	var isAmbiguous, isSameFile, isSameRank bool
+	for _, legalMove := range legalMoves {
+		if legalMove == move || legalMove.Finish != move.Finish {
+			continue
+		}
+
+		otherPiece, _ := storage.Piece(legalMove.Start)
+		if otherPiece.Kind() != piece.Kind() {
+			continue
+		}
+
+		isAmbiguous = true
+		isSameFile = isSameFile || legalMove.Start.File == move.Start.File
+		isSameRank = isSameRank || legalMove.Start.Rank == move.Start.Rank
+	}
+
+	var text strings.Builder
+	_, isCapture := storage.Piece(move.Finish)
+	if piece.Kind() == models.Pawn {
+		if isCapture {
+			text.WriteByte(fileSymbol(move.Start))
+		}
+	} else {
+		text.WriteByte(kindSymbol(piece.Kind()))
+		switch {
+		case !isAmbiguous:
+		case !isSameFile:
+			text.WriteByte(fileSymbol(move.Start))
+		case !isSameRank:
+			text.WriteByte(rankSymbol(move.Start))
+		default:
+			text.WriteByte(fileSymbol(move.Start))
+			text.WriteByte(rankSymbol(move.Start))
+		}
+	}
+	if isCapture {
+		text.WriteByte('x')
+	}
+	text.WriteByte(fileSymbol(move.Finish))
+	text.WriteByte(rankSymbol(move.Finish))
+
+	nextStorage := storage.ApplyMove(move)
+	var generator models.MoveGenerator
+	_, err = generator.MovesForColor(nextStorage, color)
+	if err == models.ErrKingCapture {
+		replies, err := moves.LegalMoves(
+			models.MoveGenerator{},
+			nextStorage,
+			color.Negative(),
+		)
+		if err != nil {
+			return "", err
+		}
+
+		if len(replies) == 0 {
+			text.WriteByte('#')
+		} else {
+			text.WriteByte('+')
+		}
+	}
+
+	return text.String(), nil
+}
+
+// EncodeMoves ...
+//
+// It encodes moves played one after another from a position.
+func EncodeMoves(
+	storage models.PieceStorage,
+	color models.Color,
+	moves []models.Move,
+) ([]Move, error) {
+	var gameMoves []Move
+	for ply, move := range moves {
+		text, err := EncodeMove(storage, color, move)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode the move #%d: %v", ply+1, err)
+		}
+
+		gameMoves = append(gameMoves, Move{SAN: text})
+		storage = storage.ApplyMove(move)
+		color = color.Negative()
+	}
+
+	return gameMoves, nil
+}
+
+func parseSAN(text string) (sanMove, error) {
+	text = strings.TrimRight(text, "+#!?")
+	if strings.HasPrefix(text, "O-O") || strings.HasPrefix(text, "0-0") {
+		return sanMove{}, ErrUnsupportedMove
+	}
+	if strings.ContainsRune(text, '=') {
+		return sanMove{}, ErrUnsupportedMove
+	}
+	if len(text) < 2 {
+		return sanMove{}, ErrInvalidMove
+	}
+
+	move := sanMove{kind: models.Pawn, startFile: -1, startRank: -1}
+	if kind, ok := pieceKinds[text[0]]; ok {
+		move.kind = kind
+		text = text[1:]
+	}
+	// a promotion may be written without the equal sign, e.g. "e8Q"
+	if _, ok := pieceKinds[text[len(text)-1]]; ok {
+		return sanMove{}, ErrUnsupportedMove
+	}
+	if len(text) < 2 {
+		return sanMove{}, ErrInvalidMove
+	}
+
+	finish, ok := parsePosition(text[len(text)-2:])
+	if !ok {
+		return sanMove{}, ErrInvalidMove
+	}
+
+	move.finish = finish
+	for _, symbol := range strings.TrimSuffix(text[:len(text)-2], "x") {
+		switch {
+		case symbol >= 'a' && symbol <= 'h' && move.startFile == -1:
+			move.startFile = int(symbol - 'a')
+		case symbol >= '1' && symbol <= '8' && move.startRank == -1:
+			move.startRank = int(symbol - '1')
+		default:
+			return sanMove{}, ErrInvalidMove
+		}
+	}
+
+	return move, nil
+}
+
+func parsePosition(text string) (models.Position, bool) {
+	if text[0] < 'a' || text[0] > 'h' || text[1] < '1' || text[1] > '8' {
+		return models.Position{}, false
+	}
+
+	position := models.Position{
+		File: int(text[0] - 'a'),
+		Rank: int(text[1] - '1'),
+	}
+	return position, true
+}
+
+func containsMove(moves []models.Move, move models.Move) bool {
+	for _, other := range moves {
+		if other == move {
+			return true
+		}
+	}
+
+	return false
+}
+
+func kindSymbol(kind models.Kind) byte {
+	for symbol, symbolKind := range pieceKinds {
+		if symbolKind == kind {
+			return symbol
+		}
+	}
+
+	return 0
+}
+
+func fileSymbol(position models.Position) byte {
+	return byte('a' + position.File)
+}
+
+func rankSymbol(position models.Position) byte {
+	return byte('1' + position.Rank)
+}
+
+func (move sanMove) isMatched(
+	storage models.PieceStorage,
+	candidate models.Move,
+) bool {
+	if candidate.Finish != move.finish {
+		return false
+	}
+	if move.startFile != -1 && candidate.Start.File != move.startFile {
+		return false
+	}
+	if move.startRank != -1 && candidate.Start.Rank != move.startRank {
+		return false
+	}
+
+	piece, ok := storage.Piece(candidate.Start)
+	return ok && piece.Kind() == move.kind
+}
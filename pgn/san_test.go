@@ -0,0 +1,391 @@
+package pgn
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodeMove(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		color      models.Color
+		text       string
+	}
+	type data struct {
+		args     args
+		wantMove models.Move
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				text:       "e4",
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 4, Rank: 1},
+				Finish: models.Position{File: 4, Rank: 3},
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.Black,
+				text:       "Nf6!?",
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 6, Rank: 7},
+				Finish: models.Position{File: 5, Rank: 5},
+			},
+			wantErr: nil,
+		},
+		// a capture with a check
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/3p4/4P3/8/8/4K3",
+				color:      models.White,
+				text:       "exd5+",
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 4, Rank: 3},
+				Finish: models.Position{File: 3, Rank: 4},
+			},
+			wantErr: nil,
+		},
+		// a disambiguation by a file
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/8/8/8/4K3/R6R",
+				color:      models.White,
+				text:       "Rhf1",
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 7, Rank: 0},
+				Finish: models.Position{File: 5, Rank: 0},
+			},
+			wantErr: nil,
+		},
+		// a disambiguation by a rank
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/8/R7/8/8/R3K3",
+				color:      models.White,
+				text:       "R1a2",
+			},
+			wantMove: models.Move{
+				Start:  models.Position{File: 0, Rank: 0},
+				Finish: models.Position{File: 0, Rank: 1},
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/8/8/8/4K3/R6R",
+				color:      models.White,
+				text:       "Rf1",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrAmbiguousMove,
+		},
+		// a pinned piece
+		{
+			args: args{
+				boardInFEN: "4r1k1/8/8/8/8/8/4B3/4K3",
+				color:      models.White,
+				text:       "Bd3",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrIllegalMove,
+		},
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				text:       "e5",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrIllegalMove,
+		},
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				text:       "O-O",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrUnsupportedMove,
+		},
+		{
+			args: args{
+				boardInFEN: "4k3/P7/8/8/8/8/8/4K3",
+				color:      models.White,
+				text:       "a8=Q",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrUnsupportedMove,
+		},
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				text:       "Nz9",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrInvalidMove,
+		},
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				text:       "N",
+			},
+			wantMove: models.Move{},
+			wantErr:  ErrInvalidMove,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		gotMove, gotErr := DecodeMove(storage, data.args.color, data.args.text)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeMove(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		color      models.Color
+		move       models.Move
+	}
+	type data struct {
+		args     args
+		wantText string
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 4, Rank: 3},
+				},
+			},
+			wantText: "e4",
+			wantErr:  nil,
+		},
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.Black,
+				move: models.Move{
+					Start:  models.Position{File: 6, Rank: 7},
+					Finish: models.Position{File: 5, Rank: 5},
+				},
+			},
+			wantText: "Nf6",
+			wantErr:  nil,
+		},
+		// a capture by a pawn
+		{
+			args: args{
+				boardInFEN: "8/2k5/8/3p4/4P3/8/8/4K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 4, Rank: 3},
+					Finish: models.Position{File: 3, Rank: 4},
+				},
+			},
+			wantText: "exd5",
+			wantErr:  nil,
+		},
+		// a capture by a pawn with a check
+		{
+			args: args{
+				boardInFEN: "8/8/4k3/3p4/4P3/8/8/4K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 4, Rank: 3},
+					Finish: models.Position{File: 3, Rank: 4},
+				},
+			},
+			wantText: "exd5+",
+			wantErr:  nil,
+		},
+		// a disambiguation by a file
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/8/8/8/4K3/R6R",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 7, Rank: 0},
+					Finish: models.Position{File: 5, Rank: 0},
+				},
+			},
+			wantText: "Rhf1",
+			wantErr:  nil,
+		},
+		// a disambiguation by a rank
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/8/R7/8/8/R3K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 0, Rank: 0},
+					Finish: models.Position{File: 0, Rank: 1},
+				},
+			},
+			wantText: "R1a2",
+			wantErr:  nil,
+		},
+		// a disambiguation by a file and a rank
+		{
+			args: args{
+				boardInFEN: "4k3/8/8/8/8/Q1Q5/8/Q3K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 0, Rank: 2},
+					Finish: models.Position{File: 1, Rank: 1},
+				},
+			},
+			wantText: "Qa3b2",
+			wantErr:  nil,
+		},
+		// a checkmate
+		{
+			args: args{
+				boardInFEN: "6k1/5ppp/8/8/8/8/8/R3K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 0, Rank: 0},
+					Finish: models.Position{File: 0, Rank: 7},
+				},
+			},
+			wantText: "Ra8#",
+			wantErr:  nil,
+		},
+		// a capture with a check
+		{
+			args: args{
+				boardInFEN: "r5k1/5pp1/8/8/8/8/8/R3K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 0, Rank: 0},
+					Finish: models.Position{File: 0, Rank: 7},
+				},
+			},
+			wantText: "Rxa8+",
+			wantErr:  nil,
+		},
+		// an empty start
+		{
+			args: args{
+				boardInFEN: moves.InitialBoardInFEN,
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 4, Rank: 3},
+					Finish: models.Position{File: 4, Rank: 4},
+				},
+			},
+			wantText: "",
+			wantErr:  ErrIllegalMove,
+		},
+		// a pinned piece
+		{
+			args: args{
+				boardInFEN: "4r1k1/8/8/8/8/8/4B3/4K3",
+				color:      models.White,
+				move: models.Move{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 3, Rank: 2},
+				},
+			},
+			wantText: "",
+			wantErr:  ErrIllegalMove,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			models.NewBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		gotText, gotErr := EncodeMove(storage, data.args.color, data.args.move)
+
+		if gotText != data.wantText {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeMoves(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		moves.InitialBoardInFEN,
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	kingPawnMove := models.Move{
+		Start:  models.Position{File: 4, Rank: 1},
+		Finish: models.Position{File: 4, Rank: 3},
+	}
+	kingPawnReply := models.Move{
+		Start:  models.Position{File: 4, Rank: 6},
+		Finish: models.Position{File: 4, Rank: 4},
+	}
+
+	gotMoves, gotErr := EncodeMoves(
+		storage,
+		models.White,
+		[]models.Move{kingPawnMove, kingPawnReply},
+	)
+	if !reflect.DeepEqual(gotMoves, []Move{{SAN: "e4"}, {SAN: "e5"}}) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+
+	gotMoves, gotErr = EncodeMoves(
+		storage,
+		models.White,
+		[]models.Move{kingPawnMove, kingPawnMove},
+	)
+	if gotMoves != nil {
+		test.Fail()
+	}
+	if gotErr == nil {
+		test.Fail()
+	}
+}
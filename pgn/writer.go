@@ -0,0 +1,148 @@
+package pgn
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// it's a maximal length of a line of movetext by the PGN standard
+const maximalLineLength = 79
+
+// Writer ...
+//
+// It writes games in the PGN format one by one.
+type Writer struct {
+	writer io.Writer
+}
+
+// NewWriter ...
+func NewWriter(writer io.Writer) *Writer {
+	return &Writer{writer: writer}
+}
+
+// Write ...
+//
+// It writes a game with its comments, NAGs and variations. Move numbers
+// are restored by the FEN tag, if it's present. An empty result is written
+// as an unknown one.
+func (writer *Writer) Write(game Game) error {
+	_, color, err := game.InitialPosition()
+	if err != nil {
+		return err
+	}
+
+	var text strings.Builder
+	for _, tag := range game.Tags {
+		fmt.Fprintf(&text, "[%s \"%s\"]\n", tag.Name, escapeTagValue(tag.Value))
+	}
+	if len(game.Tags) != 0 {
+		text.WriteByte('\n')
+	}
+
+	result := game.Result
+	if result == "" {
+		result = UnknownResult
+	}
+
+	mainLine := Variation{Comments: game.Comments, Moves: game.Moves}
+	tokens := encodeLine(game.initialMoveNumber(), color, mainLine)
+	writeTokens(&text, append(tokens, result))
+	text.WriteString("\n\n")
+
+	_, err = io.WriteString(writer.writer, text.String())
+	return err
+}
+
+// WriteAll ...
+func (writer *Writer) WriteAll(games []Game) error {
+	for _, game := range games {
+		if err := writer.Write(game); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func escapeTagValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
+
+func encodeLine(number int, color models.Color, line Variation) []string {
+	var tokens []string
+	for _, comment := range line.Comments {
+		tokens = append(tokens, encodeComment(comment)...)
+	}
+
+	// a number of a move of Black is needed after a break in movetext
+	isNumberNeeded := true
+	for _, move := range line.Moves {
+		switch {
+		case color == models.White:
+			tokens = append(tokens, fmt.Sprintf("%d.", number))
+		case isNumberNeeded:
+			tokens = append(tokens, fmt.Sprintf("%d...", number))
+		}
+
+		tokens = append(tokens, move.SAN)
+		for _, nag := range move.NAGs {
+			tokens = append(tokens, fmt.Sprintf("$%d", nag))
+		}
+		for _, comment := range move.Comments {
+			tokens = append(tokens, encodeComment(comment)...)
+		}
+		for _, variation := range move.Variations {
+			variationTokens := encodeLine(number, color, variation)
+			if len(variationTokens) == 0 {
+				tokens = append(tokens, "()")
+				continue
+			}
+
+			variationTokens[0] = "(" + variationTokens[0]
+			variationTokens[len(variationTokens)-1] += ")"
+			tokens = append(tokens, variationTokens...)
+		}
+
+		isNumberNeeded = len(move.Comments) != 0 || len(move.Variations) != 0
+		if color == models.Black {
+			number++
+		}
+		color = color.Negative()
+	}
+
+	return tokens
+}
+
+// it splits a comment into words, so a long comment can be wrapped;
+// a closing brace can't be escaped in a comment, so it's removed
+func encodeComment(comment string) []string {
+	words := strings.Fields(strings.Replace(comment, "}", "", -1))
+	if len(words) == 0 {
+		return []string{"{}"}
+	}
+
+	words[0] = "{" + words[0]
+	words[len(words)-1] += "}"
+	return words
+}
+
+func writeTokens(text *strings.Builder, tokens []string) {
+	var lineLength int
+	for _, movetextToken := range tokens {
+		switch {
+		case lineLength == 0:
+		case lineLength+1+len(movetextToken) > maximalLineLength:
+			text.WriteByte('\n')
+			lineLength = 0
+		default:
+			text.WriteByte(' ')
+			lineLength++
+		}
+
+		text.WriteString(movetextToken)
+		lineLength += len(movetextToken)
+	}
+}
@@ -0,0 +1,179 @@
+package pgn
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(data []byte) (int, error) {
+	return 0, errors.New("dummy")
+}
+
+func TestWriterWrite(test *testing.T) {
+	type data struct {
+		game     Game
+		wantText string
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			game: Game{
+				Tags: []Tag{
+					{Name: "Event", Value: `Test "game" \ 1`},
+					{Name: "Result", Value: "1-0"},
+				},
+				Comments: []string{"a game comment"},
+				Moves: []Move{
+					{SAN: "e4", Comments: []string{"+0.35/12"}},
+					{
+						SAN:  "e5",
+						NAGs: []int{1},
+						Variations: []Variation{
+							{
+								Comments: []string{"a variation }comment"},
+								Moves:    []Move{{SAN: "c5"}, {SAN: "Nf3"}},
+							},
+							{},
+						},
+					},
+					{SAN: "Nf3"},
+				},
+				Result: WhiteWin,
+			},
+			wantText: `[Event "Test \"game\" \\ 1"]
+[Result "1-0"]
+
+{a game comment} 1. e4 {+0.35/12} 1... e5 $1 ({a variation comment} 1... c5 2.
+Nf3) () 2. Nf3 1-0
+
+`,
+			wantErr: false,
+		},
+		// a position from the FEN tag without a result
+		{
+			game: Game{
+				Tags: []Tag{
+					{Name: "FEN", Value: "4k3/8/8/8/8/8/8/4K3 b - - 0 12"},
+				},
+				Moves: []Move{{SAN: "Kd7"}, {SAN: "Kd2"}},
+			},
+			wantText: `[FEN "4k3/8/8/8/8/8/8/4K3 b - - 0 12"]
+
+12... Kd7 13. Kd2 *
+
+`,
+			wantErr: false,
+		},
+		{
+			game:     Game{Tags: []Tag{{Name: "FEN", Value: ""}}},
+			wantText: "",
+			wantErr:  true,
+		},
+	} {
+		var buffer bytes.Buffer
+		gotErr := NewWriter(&buffer).Write(data.game)
+
+		if buffer.String() != data.wantText {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestWriterWriteWithLongMovetext(test *testing.T) {
+	var game Game
+	for i := 0; i < 50; i++ {
+		game.Moves = append(game.Moves, Move{SAN: "Nf3"}, Move{SAN: "Nf6"})
+	}
+
+	var buffer bytes.Buffer
+	if err := NewWriter(&buffer).Write(game); err != nil {
+		test.Fatal(err)
+	}
+
+	for _, line := range strings.Split(buffer.String(), "\n") {
+		if len(line) > maximalLineLength {
+			test.Fail()
+		}
+	}
+
+	// the written game can be read back
+	gotGame, err := NewReader(&buffer).Read()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	game.Result = UnknownResult
+	if !reflect.DeepEqual(gotGame, game) {
+		test.Fail()
+	}
+}
+
+func TestWriterWriteWithLongComment(test *testing.T) {
+	comment := strings.Repeat("a long comment ", 10) + "end"
+	game := Game{
+		Comments: []string{comment},
+		Moves:    []Move{{SAN: "e4", Comments: []string{comment}}},
+	}
+
+	var buffer bytes.Buffer
+	if err := NewWriter(&buffer).Write(game); err != nil {
+		test.Fatal(err)
+	}
+
+	for _, line := range strings.Split(buffer.String(), "\n") {
+		if len(line) > maximalLineLength {
+			test.Fail()
+		}
+	}
+
+	// the written game can be read back
+	gotGame, err := NewReader(&buffer).Read()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	game.Result = UnknownResult
+	if !reflect.DeepEqual(gotGame, game) {
+		test.Fail()
+	}
+}
+
+func TestWriterWriteAll(test *testing.T) {
+	games := []Game{
+		{Moves: []Move{{SAN: "e4"}}, Result: WhiteWin},
+		{Moves: []Move{{SAN: "d4"}}, Result: Draw},
+	}
+
+	var buffer bytes.Buffer
+	err := NewWriter(&buffer).WriteAll(games)
+
+	wantText := "1. e4 1-0\n\n1. d4 1/2-1/2\n\n"
+	if buffer.String() != wantText {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+
+	gotGames, err := NewReader(&buffer).ReadAll()
+	if err != nil {
+		test.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotGames, games) {
+		test.Fail()
+	}
+
+	err = NewWriter(failingWriter{}).WriteAll(games)
+	if err == nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,106 @@
+package chessminimax
+
+import (
+	"errors"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// ErrNotPondering ...
+var ErrNotPondering = errors.New("not pondering")
+
+// Ponderer ...
+//
+// It searches a predicted position in the background while an opponent
+// thinks. The position is usually obtained by applying a returned best move
+// and an expected reply (see ExpectedReply()). The searcher should share
+// a cache with a main search, so both of them benefit from each other.
+//
+// Pondering is infinite until a ponder hit or a ponder miss. On a ponder hit,
+// the search is converted to a normal one by switching its terminator, so it
+// keeps its progress; on a ponder miss, the search is stopped and its result
+// is discarded.
+//
+// Its methods should be called from a single goroutine.
+type Ponderer struct {
+	searcher MoveSearcher
+
+	stopper    *terminators.ManualTerminator
+	terminator *terminators.SwitchableTerminator
+	result     chan moves.FailedMove
+}
+
+// NewPonderer ...
+func NewPonderer(searcher MoveSearcher) *Ponderer {
+	return &Ponderer{searcher: searcher}
+}
+
+// IsPondering ...
+func (ponderer *Ponderer) IsPondering() bool {
+	return ponderer.result != nil
+}
+
+// Ponder ...
+//
+// It starts a background search of the passed position. A previous
+// pondering, if any, is stopped as on a ponder miss.
+func (ponderer *Ponderer) Ponder(
+	storage models.PieceStorage,
+	color models.Color,
+) {
+	ponderer.PonderMiss()
+
+	ponderer.stopper = new(terminators.ManualTerminator)
+	ponderer.terminator = terminators.NewSwitchableTerminator(ponderer.stopper)
+	ponderer.result = make(chan moves.FailedMove, 1)
+	ponderer.searcher.SetTerminator(ponderer.terminator)
+
+	go func(result chan<- moves.FailedMove) {
+		move, err :=
+			ponderer.searcher.SearchMove(storage, color, 0, moves.NewBounds())
+		result <- moves.FailedMove{Move: move, Error: err}
+	}(ponderer.result)
+}
+
+// PonderHit ...
+//
+// It continues a current pondering with the passed terminator
+// and returns its result.
+//
+// It returns ErrNotPondering, if there is no pondering.
+func (ponderer *Ponderer) PonderHit(
+	terminator terminators.SearchTerminator,
+) (moves.ScoredMove, error) {
+	if !ponderer.IsPondering() {
+		return moves.ScoredMove{}, ErrNotPondering
+	}
+
+	ponderer.terminator.Switch(terminator)
+
+	result := ponderer.finish()
+	return result.Move, result.Error
+}
+
+// PonderMiss ...
+//
+// It stops a current pondering, if any, and discards its result.
+func (ponderer *Ponderer) PonderMiss() {
+	if !ponderer.IsPondering() {
+		return
+	}
+
+	ponderer.stopper.Terminate()
+	ponderer.finish()
+}
+
+func (ponderer *Ponderer) finish() moves.FailedMove {
+	result := <-ponderer.result
+
+	ponderer.stopper = nil
+	ponderer.terminator = nil
+	ponderer.result = nil
+
+	return result
+}
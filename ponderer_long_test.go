@@ -0,0 +1,64 @@
+// +build long
+
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestPonderer(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"6K1/8/7q/6p1/8/2B5/pp4PQ/k7",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	innerSearcher := NewAlphaBetaSearcher(
+		generator,
+		nil, // terminator will be set automatically by the iterative searcher
+		evaluator,
+	)
+
+	cache := caches.NewParallelCache(
+		caches.NewStringHashingCache(1e6, uci.EncodePieceStorage),
+	)
+	// make and bind a cached searcher to inner one
+	NewCachedSearcher(innerSearcher, cache)
+	searcher := NewIterativeSearcher(
+		innerSearcher,
+		nil, // terminator will be set automatically by the ponderer
+	)
+
+	ponderer := NewPonderer(searcher)
+	ponderer.Ponder(storage, models.White)
+	// an iteration terminated by a deep is discarded, so a last completed one
+	// has a deep 2, where a checkmate is found
+	gotMove, gotErr := ponderer.PonderHit(terminators.NewDeepTerminator(3))
+
+	wantMove := models.Move{
+		Start:  models.Position{File: 7, Rank: 1},
+		Finish: models.Position{File: 6, Rank: 0},
+	}
+	if !reflect.DeepEqual(gotMove.Move, wantMove) {
+		test.Fail()
+	}
+	if gotMove.Score != -evaluateCheckmate(1) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
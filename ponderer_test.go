@@ -0,0 +1,114 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewPonderer(test *testing.T) {
+	var searcher MockMoveSearcher
+	ponderer := NewPonderer(searcher)
+
+	if _, ok := ponderer.searcher.(MockMoveSearcher); !ok {
+		test.Fail()
+	}
+	if ponderer.IsPondering() {
+		test.Fail()
+	}
+}
+
+func TestPondererPonderHit(test *testing.T) {
+	ponderer := NewPonderer(makeMockInfiniteSearcher(test))
+	ponderer.Ponder(MockPieceStorage{}, models.White)
+	if !ponderer.IsPondering() {
+		test.Fail()
+	}
+
+	gotMove, gotErr := ponderer.PonderHit(terminators.NewDeepTerminator(1))
+
+	wantMove := moves.ScoredMove{
+		Move: models.Move{
+			Start:  models.Position{File: 1, Rank: 2},
+			Finish: models.Position{File: 3, Rank: 4},
+		},
+		Score: 2.3,
+	}
+	if !reflect.DeepEqual(gotMove, wantMove) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+	if ponderer.IsPondering() {
+		test.Fail()
+	}
+}
+
+func TestPondererPonderHit_withoutPondering(test *testing.T) {
+	var searcher MockMoveSearcher
+	gotMove, gotErr :=
+		NewPonderer(searcher).PonderHit(terminators.NewDeepTerminator(1))
+
+	if !reflect.DeepEqual(gotMove, moves.ScoredMove{}) {
+		test.Fail()
+	}
+	if gotErr != ErrNotPondering {
+		test.Fail()
+	}
+}
+
+func TestPondererPonderMiss(test *testing.T) {
+	ponderer := NewPonderer(makeMockInfiniteSearcher(test))
+	ponderer.PonderMiss() // it should do nothing without pondering
+
+	ponderer.Ponder(MockPieceStorage{}, models.White)
+	// it should stop a previous pondering
+	ponderer.Ponder(MockPieceStorage{}, models.White)
+	ponderer.PonderMiss()
+
+	if ponderer.IsPondering() {
+		test.Fail()
+	}
+}
+
+// it searches until its terminator fires
+func makeMockInfiniteSearcher(test *testing.T) MockMoveSearcher {
+	terminator := new(terminators.SearchTerminator)
+	return MockMoveSearcher{
+		setTerminator: func(innerTerminator terminators.SearchTerminator) {
+			*terminator = innerTerminator
+		},
+		searchMove: func(
+			storage models.PieceStorage,
+			color models.Color,
+			deep int,
+			bounds moves.Bounds,
+		) (moves.ScoredMove, error) {
+			if _, ok := storage.(MockPieceStorage); !ok {
+				test.Fail()
+			}
+			if color != models.White {
+				test.Fail()
+			}
+			if deep != 0 {
+				test.Fail()
+			}
+			if !reflect.DeepEqual(bounds, moves.NewBounds()) {
+				test.Fail()
+			}
+
+			for deep := 0; !(*terminator).IsSearchTerminated(deep); deep++ {
+			}
+
+			move := models.Move{
+				Start:  models.Position{File: 1, Rank: 2},
+				Finish: models.Position{File: 3, Rank: 4},
+			}
+			return moves.ScoredMove{Move: move, Score: 2.3}, nil
+		},
+	}
+}
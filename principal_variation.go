@@ -0,0 +1,63 @@
+package chessminimax
+
+import (
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// PrincipalVariation ...
+//
+// It restores a sequence of best moves starting from the passed position
+// by walking through the cache filled by CachedSearcher. The sequence ends
+// on a position missing in the cache, ended a game or with an inexact score,
+// because a move of the latter one may be only a refutation of a worse move
+// instead of a best one. The maximal length guards against cycles
+// of repeated positions.
+//
+// If CachedSearcher is bound only to an inner searcher, a root of a search
+// isn't cached, so a variation should be started from a position after
+// a found move (see ExpectedReply()).
+func PrincipalVariation(
+	cache caches.Cache,
+	storage models.PieceStorage,
+	color models.Color,
+	maximalLength int,
+) []models.Move {
+	var variation []models.Move
+	for len(variation) < maximalLength {
+		data, ok := cache.Get(storage, color)
+		if !ok || data.Error != nil || data.Move.Move.IsZero() ||
+			data.Bound != moves.ExactBound {
+			break
+		}
+
+		move := data.Move.Move
+		variation = append(variation, move)
+
+		storage = storage.ApplyMove(move)
+		color = color.Negative()
+	}
+
+	return variation
+}
+
+// ExpectedReply ...
+//
+// It returns an expected reply of an opponent to the passed move
+// by the principal variation, e.g. for pondering.
+func ExpectedReply(
+	cache caches.Cache,
+	storage models.PieceStorage,
+	color models.Color,
+	move models.Move,
+) (reply models.Move, ok bool) {
+	nextStorage := storage.ApplyMove(move)
+	nextColor := color.Negative()
+	variation := PrincipalVariation(cache, nextStorage, nextColor, 1)
+	if len(variation) == 0 {
+		return models.Move{}, false
+	}
+
+	return variation[0], true
+}
@@ -0,0 +1,197 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestPrincipalVariation(test *testing.T) {
+	type args struct {
+		entries       map[string]moves.FailedMove
+		maximalLength int
+	}
+	type data struct {
+		args args
+		want []models.Move
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				entries:       nil,
+				maximalLength: 5,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				entries: map[string]moves.FailedMove{
+					"": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 1}},
+						},
+					},
+					"b": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 2}},
+						},
+					},
+				},
+				maximalLength: 5,
+			},
+			want: []models.Move{
+				{Start: models.Position{File: 1}},
+				{Start: models.Position{File: 2}},
+			},
+		},
+		{
+			args: args{
+				entries: map[string]moves.FailedMove{
+					"": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 1}},
+						},
+					},
+					"b": {
+						Move:  moves.ScoredMove{Score: -1e6},
+						Error: ErrCheckmate,
+					},
+				},
+				maximalLength: 5,
+			},
+			want: []models.Move{
+				{Start: models.Position{File: 1}},
+			},
+		},
+		{
+			args: args{
+				entries: map[string]moves.FailedMove{
+					"": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 1}},
+						},
+					},
+					"b": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 2}},
+						},
+					},
+				},
+				maximalLength: 1,
+			},
+			want: []models.Move{
+				{Start: models.Position{File: 1}},
+			},
+		},
+		{
+			args: args{
+				entries: map[string]moves.FailedMove{
+					"": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 1}},
+						},
+					},
+					"b": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 2}},
+						},
+						Bound: moves.LowerBound,
+					},
+					"bc": {
+						Move: moves.ScoredMove{
+							Move: models.Move{Start: models.Position{File: 3}},
+						},
+					},
+				},
+				maximalLength: 5,
+			},
+			want: []models.Move{
+				{Start: models.Position{File: 1}},
+			},
+		},
+	} {
+		var gotColors []models.Color
+		cache := MockCache{
+			get: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) (move moves.FailedMove, ok bool) {
+				gotColors = append(gotColors, color)
+
+				move, ok = data.args.entries[storage.(MockTreeStorage).path]
+				return move, ok
+			},
+		}
+		got := PrincipalVariation(
+			cache,
+			MockTreeStorage{},
+			models.White,
+			data.args.maximalLength,
+		)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		for index, color := range gotColors {
+			wantColor := models.White
+			if index%2 != 0 {
+				wantColor = models.Black
+			}
+			if color != wantColor {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestExpectedReply(test *testing.T) {
+	cache := MockCache{
+		get: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) (move moves.FailedMove, ok bool) {
+			if storage.(MockTreeStorage).path != "b" {
+				return moves.FailedMove{}, false
+			}
+			if color != models.Black {
+				test.Fail()
+			}
+
+			move = moves.FailedMove{
+				Move: moves.ScoredMove{
+					Move: models.Move{Start: models.Position{File: 2}},
+				},
+			}
+			return move, true
+		},
+	}
+
+	gotReply, gotOk := ExpectedReply(
+		cache,
+		MockTreeStorage{},
+		models.White,
+		models.Move{Start: models.Position{File: 1}},
+	)
+	if !reflect.DeepEqual(gotReply, models.Move{Start: models.Position{File: 2}}) {
+		test.Fail()
+	}
+	if !gotOk {
+		test.Fail()
+	}
+
+	gotReply, gotOk = ExpectedReply(
+		cache,
+		MockTreeStorage{},
+		models.White,
+		models.Move{Start: models.Position{File: 2}},
+	)
+	if !reflect.DeepEqual(gotReply, models.Move{}) {
+		test.Fail()
+	}
+	if gotOk {
+		test.Fail()
+	}
+}
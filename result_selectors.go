@@ -0,0 +1,86 @@
+package chessminimax
+
+import (
+	"math"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// DeepReporter ...
+//
+// It's implemented by searchers that can report a deep of their last result,
+// e.g. IterativeSearcher.
+type DeepReporter interface {
+	CompletedDeep() int
+}
+
+// WorkerResult ...
+//
+// It's a result of a single worker of ParallelSearcher. Its deep is zero,
+// if a worker searcher doesn't implement the DeepReporter interface
+// or hasn't completed any iteration.
+type WorkerResult struct {
+	moves.FailedMove
+
+	Deep int
+}
+
+// ResultSelector ...
+//
+// It selects a single result of ParallelSearcher from results of its workers.
+// They are passed in an order of finishing, so there is always at least one.
+type ResultSelector func(results []WorkerResult) WorkerResult
+
+// SelectFirstResult ...
+//
+// It selects a result of a worker, which has finished first.
+func SelectFirstResult(results []WorkerResult) WorkerResult {
+	return results[0]
+}
+
+// SelectDeepestResult ...
+//
+// It selects a result with a maximal deep. Of same ones it selects
+// a result of a worker, which has finished first.
+func SelectDeepestResult(results []WorkerResult) WorkerResult {
+	deepestResult := results[0]
+	for _, result := range results[1:] {
+		if result.Deep > deepestResult.Deep {
+			deepestResult = result
+		}
+	}
+
+	return deepestResult
+}
+
+// SelectVotedResult ...
+//
+// It selects a move by a vote of workers weighted by their deeps and scores.
+// Of results with the selected move it selects a deepest one.
+func SelectVotedResult(results []WorkerResult) WorkerResult {
+	minimalScore := math.Inf(+1)
+	for _, result := range results {
+		minimalScore = math.Min(minimalScore, result.Move.Score)
+	}
+
+	votes := make(map[models.Move]float64)
+	for _, result := range results {
+		// shift scores and deeps by one so that every worker has a voice
+		vote := (result.Move.Score - minimalScore + 1) * float64(result.Deep+1)
+		votes[result.Move.Move] += vote
+	}
+
+	var votedResult WorkerResult
+	maximalVotes := math.Inf(-1)
+	for _, result := range results {
+		resultVotes := votes[result.Move.Move]
+		if resultVotes > maximalVotes ||
+			(resultVotes == maximalVotes && result.Deep > votedResult.Deep) {
+			votedResult = result
+			maximalVotes = resultVotes
+		}
+	}
+
+	return votedResult
+}
@@ -0,0 +1,125 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+var (
+	testMoveOne = models.Move{
+		Start:  models.Position{File: 1, Rank: 2},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+	testMoveTwo = models.Move{
+		Start:  models.Position{File: 5, Rank: 6},
+		Finish: models.Position{File: 7, Rank: 8},
+	}
+)
+
+func TestSelectFirstResult(test *testing.T) {
+	results := []WorkerResult{
+		makeWorkerResult(testMoveOne, 2.3, 1),
+		makeWorkerResult(testMoveTwo, 4.2, 2),
+	}
+	got := SelectFirstResult(results)
+
+	if !reflect.DeepEqual(got, results[0]) {
+		test.Fail()
+	}
+}
+
+func TestSelectDeepestResult(test *testing.T) {
+	type data struct {
+		results []WorkerResult
+		want    WorkerResult
+	}
+
+	for _, data := range []data{
+		{
+			results: []WorkerResult{makeWorkerResult(testMoveOne, 2.3, 1)},
+			want:    makeWorkerResult(testMoveOne, 2.3, 1),
+		},
+		{
+			results: []WorkerResult{
+				makeWorkerResult(testMoveOne, 2.3, 1),
+				makeWorkerResult(testMoveTwo, 4.2, 3),
+				makeWorkerResult(testMoveOne, 1, 2),
+			},
+			want: makeWorkerResult(testMoveTwo, 4.2, 3),
+		},
+		// of same deeps a first result is selected
+		{
+			results: []WorkerResult{
+				makeWorkerResult(testMoveOne, 2.3, 2),
+				makeWorkerResult(testMoveTwo, 4.2, 2),
+			},
+			want: makeWorkerResult(testMoveOne, 2.3, 2),
+		},
+	} {
+		got := SelectDeepestResult(data.results)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSelectVotedResult(test *testing.T) {
+	type data struct {
+		results []WorkerResult
+		want    WorkerResult
+	}
+
+	for _, data := range []data{
+		{
+			results: []WorkerResult{makeWorkerResult(testMoveOne, 2.3, 1)},
+			want:    makeWorkerResult(testMoveOne, 2.3, 1),
+		},
+		// many shallow votes outweigh a single deep one
+		{
+			results: []WorkerResult{
+				makeWorkerResult(testMoveOne, 1, 1),
+				makeWorkerResult(testMoveOne, 1, 2),
+				makeWorkerResult(testMoveTwo, 1, 3),
+			},
+			want: makeWorkerResult(testMoveOne, 1, 2),
+		},
+		// a better score outweighs more votes
+		{
+			results: []WorkerResult{
+				makeWorkerResult(testMoveOne, 1, 2),
+				makeWorkerResult(testMoveOne, 1, 2),
+				makeWorkerResult(testMoveTwo, 5, 2),
+			},
+			want: makeWorkerResult(testMoveTwo, 5, 2),
+		},
+		// of same votes a deepest result is selected
+		{
+			results: []WorkerResult{
+				makeWorkerResult(testMoveOne, 1, 1),
+				makeWorkerResult(testMoveTwo, 1, 1),
+				makeWorkerResult(testMoveTwo, 1, 1),
+				makeWorkerResult(testMoveOne, 1, 3),
+			},
+			want: makeWorkerResult(testMoveOne, 1, 3),
+		},
+	} {
+		got := SelectVotedResult(data.results)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func makeWorkerResult(move models.Move, score float64, deep int) WorkerResult {
+	return WorkerResult{
+		FailedMove: moves.FailedMove{
+			Move: moves.ScoredMove{Move: move, Score: score},
+		},
+		Deep: deep,
+	}
+}
@@ -0,0 +1,220 @@
+package chessminimax
+
+import (
+	"sync"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// RootSplittingSearcher ...
+//
+// It distributes moves of a root among goroutines, each of which searches
+// a child position via the inner searcher. A best score found so far
+// is shared between goroutines to narrow windows of later searches.
+//
+// A result doesn't depend on scheduling of goroutines: of moves with a same
+// score a first generated one is selected, and scores that might be affected
+// by a narrowed window are searched again with original bounds. Therefore
+// all moves of a root are always searched.
+//
+// The inner searcher should be safe for concurrent use.
+type RootSplittingSearcher struct {
+	*SearcherSetter
+	*TerminatorSetter
+
+	generator   MoveGenerator
+	concurrency int
+}
+
+type rootMoveResult struct {
+	move      moves.ScoredMove
+	isLegal   bool
+	isUnknown bool
+}
+
+type rootState struct {
+	lock  sync.Mutex
+	alpha float64
+}
+
+// NewRootSplittingSearcher ...
+//
+// It panics, if the concurrency isn't positive, because otherwise no moves
+// would be searched.
+func NewRootSplittingSearcher(
+	generator MoveGenerator,
+	innerSearcher MoveSearcher,
+	terminator terminators.SearchTerminator,
+	concurrency int,
+) RootSplittingSearcher {
+	if concurrency <= 0 {
+		panic("non-positive concurrency")
+	}
+
+	searcher := RootSplittingSearcher{
+		SearcherSetter:   new(SearcherSetter),
+		TerminatorSetter: new(TerminatorSetter),
+
+		generator:   generator,
+		concurrency: concurrency,
+	}
+
+	searcher.SetSearcher(innerSearcher)
+	searcher.SetTerminator(terminator)
+
+	return searcher
+}
+
+// SetTerminator ...
+//
+// It also sets the terminator to the inner searcher.
+func (searcher RootSplittingSearcher) SetTerminator(
+	terminator terminators.SearchTerminator,
+) {
+	searcher.TerminatorSetter.SetTerminator(terminator)
+	searcher.searcher.SetTerminator(terminator)
+}
+
+// SearchMove ...
+func (searcher RootSplittingSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	moveGroup, err := searcher.generator.MovesForColor(storage, color)
+	if err != nil {
+		return moves.ScoredMove{}, err
+	}
+
+	if ok := searcher.terminator.IsSearchTerminated(deep); ok {
+		// the inner searcher evaluates a position the same way
+		return searcher.searcher.SearchMove(storage, color, deep, bounds)
+	}
+
+	// the inner searcher counts children, so only a root is counted here
+	terminators.CountNode(searcher.terminator, deep)
+
+	state := &rootState{alpha: bounds.Alpha}
+	results := make([]rootMoveResult, len(moveGroup))
+	indices := make(chan int, len(moveGroup))
+	for index := range moveGroup {
+		indices <- index
+	}
+	close(indices)
+
+	var waiter sync.WaitGroup
+	waiter.Add(searcher.concurrency)
+	for i := 0; i < searcher.concurrency; i++ {
+		go func() {
+			defer waiter.Done()
+
+			for index := range indices {
+				results[index] = searcher.searchRootMove(
+					storage,
+					color,
+					deep,
+					bounds,
+					moveGroup[index],
+					state,
+				)
+			}
+		}()
+	}
+	waiter.Wait()
+
+	var hasCheck bool
+	bestMove := moves.NewScoredMove()
+	for _, result := range results {
+		if !result.isLegal {
+			hasCheck = true
+			continue
+		}
+		if result.isUnknown {
+			continue
+		}
+
+		// results are processed in an order of moves, so of same scores
+		// a first one is kept
+		if !bestMove.IsUpdated() || result.move.Score > bestMove.Score {
+			bestMove = result.move
+		}
+	}
+	// has a legal move
+	if bestMove.IsUpdated() {
+		return bestMove, nil
+	}
+
+	// hasn't a legal move
+	if hasCheck && isKingUnderAttack(searcher.generator, storage, color) {
+		score := evaluateCheckmate(deep)
+		return moves.ScoredMove{Score: score}, ErrCheckmate
+	}
+
+	// score of a draw is a null
+	return moves.ScoredMove{}, ErrDraw
+}
+
+func (searcher RootSplittingSearcher) searchRootMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+	move models.Move,
+	state *rootState,
+) rootMoveResult {
+	state.lock.Lock()
+	sharedBounds := moves.Bounds{Alpha: state.alpha, Beta: bounds.Beta}
+	state.lock.Unlock()
+
+	score, err := searcher.searchChild(storage, color, deep, sharedBounds, move)
+	if err == models.ErrKingCapture {
+		return rootMoveResult{}
+	}
+
+	// a narrowed window may affect a score, which is equal to a shared best one
+	// or which causes a cutoff, so it's searched again with original bounds
+	if sharedBounds.Alpha > bounds.Alpha &&
+		(score == sharedBounds.Alpha || score >= bounds.Beta) {
+		score, _ = searcher.searchChild(storage, color, deep, bounds, move)
+	}
+
+	moveQuality := evaluateQuality(searcher, deep)
+	result := rootMoveResult{
+		move:    moves.ScoredMove{Move: move, Score: score, Quality: moveQuality},
+		isLegal: true,
+	}
+	// a score below a shared best one is only an upper bound of a real one,
+	// but such a move can't be selected anyway, because a move with that best
+	// score exists
+	if score < sharedBounds.Alpha && sharedBounds.Alpha > bounds.Alpha {
+		result.isUnknown = true
+		return result
+	}
+
+	state.lock.Lock()
+	if score > state.alpha && score < bounds.Beta {
+		state.alpha = score
+	}
+	state.lock.Unlock()
+
+	return result
+}
+
+func (searcher RootSplittingSearcher) searchChild(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+	move models.Move,
+) (float64, error) {
+	nextStorage := storage.ApplyMove(move)
+	nextColor := color.Negative()
+	nextDeep := deep + 1
+	nextBounds := bounds.Next()
+	scoredMove, err :=
+		searcher.searcher.SearchMove(nextStorage, nextColor, nextDeep, nextBounds)
+	return -scoredMove.Score, err
+}
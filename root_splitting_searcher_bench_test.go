@@ -0,0 +1,61 @@
+package chessminimax
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func BenchmarkRootSplittingSearcher_1Ply(benchmark *testing.B) {
+	for i := 0; i < benchmark.N; i++ {
+		rootSplittingSearch(initial, models.White, 1) // nolint: errcheck
+	}
+}
+
+func BenchmarkRootSplittingSearcher_2Ply(benchmark *testing.B) {
+	for i := 0; i < benchmark.N; i++ {
+		rootSplittingSearch(initial, models.White, 2) // nolint: errcheck
+	}
+}
+
+func BenchmarkRootSplittingSearcher_3Ply(benchmark *testing.B) {
+	for i := 0; i < benchmark.N; i++ {
+		rootSplittingSearch(initial, models.White, 3) // nolint: errcheck
+	}
+}
+
+func rootSplittingSearch(
+	boardInFEN string,
+	color models.Color,
+	maximalDeep int,
+) (moves.ScoredMove, error) {
+	storage, err :=
+		uci.DecodePieceStorage(boardInFEN, pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		return moves.ScoredMove{}, err
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	terminator := terminators.NewDeepTerminator(maximalDeep)
+	innerSearcher := NewAlphaBetaSearcher(generator, nil, evaluator)
+	searcher := NewRootSplittingSearcher(
+		generator,
+		innerSearcher,
+		terminator,
+		runtime.NumCPU(),
+	)
+
+	return searcher.SearchMove(
+		storage,
+		color,
+		0, // initial deep
+		moves.NewBounds(),
+	)
+}
@@ -0,0 +1,140 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewRootSplittingSearcher(test *testing.T) {
+	var generator MockMoveGenerator
+	var terminator MockSearchTerminator
+	var innerTerminator terminators.SearchTerminator
+	innerSearcher := MockMoveSearcher{
+		setTerminator: func(terminator terminators.SearchTerminator) {
+			innerTerminator = terminator
+		},
+	}
+	searcher :=
+		NewRootSplittingSearcher(generator, innerSearcher, terminator, 4)
+
+	if !reflect.DeepEqual(searcher.generator, generator) {
+		test.Fail()
+	}
+	if _, ok := searcher.searcher.(MockMoveSearcher); !ok {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.terminator, terminator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(innerTerminator, terminator) {
+		test.Fail()
+	}
+	if searcher.concurrency != 4 {
+		test.Fail()
+	}
+}
+
+func TestNewRootSplittingSearcher_withNonPositiveConcurrency(
+	test *testing.T,
+) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		var generator MockMoveGenerator
+		var innerSearcher MockMoveSearcher
+		var terminator MockSearchTerminator
+		NewRootSplittingSearcher(generator, innerSearcher, terminator, 0)
+	}()
+
+	if err != "non-positive concurrency" {
+		test.Fail()
+	}
+}
+
+func TestRootSplittingSearcherSearchMove(test *testing.T) {
+	type args struct {
+		bounds moves.Bounds
+	}
+	type data struct {
+		args args
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				bounds: moves.NewBounds(),
+			},
+		},
+		{
+			args: args{
+				bounds: moves.Bounds{Alpha: -2, Beta: 3},
+			},
+		},
+	} {
+		for seed := 0; seed < 20; seed++ {
+			generator, evaluator := makeMockTree(seed)
+			slowEvaluator := MockBoardEvaluator{
+				evaluateBoard: func(
+					storage models.PieceStorage,
+					color models.Color,
+				) float64 {
+					// shuffle an order of finishing of searches
+					hash := storage.(MockTreeStorage).hash(seed + 200)
+					time.Sleep(time.Duration(hash%3) * time.Microsecond)
+
+					return evaluator.EvaluateBoard(storage, color)
+				},
+			}
+			terminator := terminators.NewDeepTerminator(4)
+
+			search := func(concurrency int) (moves.ScoredMove, error) {
+				innerSearcher := NewAlphaBetaSearcher(generator, nil, slowEvaluator)
+				searcher := NewRootSplittingSearcher(
+					generator,
+					innerSearcher,
+					terminator,
+					concurrency,
+				)
+
+				return searcher.SearchMove(
+					MockTreeStorage{},
+					models.White,
+					0,
+					data.args.bounds,
+				)
+			}
+
+			// a serial result is a sample for other ones
+			wantMove, wantErr := search(1)
+			for _, concurrency := range []int{2, 4, 8} {
+				gotMove, gotErr := search(concurrency)
+
+				if !reflect.DeepEqual(gotMove, wantMove) {
+					test.Fail()
+				}
+				if gotErr != wantErr {
+					test.Fail()
+				}
+			}
+
+			// with infinite bounds a result should be the same as of a serial search
+			if data.args.bounds == moves.NewBounds() {
+				gotMove, gotErr := NewAlphaBetaSearcher(generator, terminator, evaluator).
+					SearchMove(MockTreeStorage{}, models.White, 0, data.args.bounds)
+
+				if !reflect.DeepEqual(gotMove, wantMove) {
+					test.Fail()
+				}
+				if gotErr != wantErr {
+					test.Fail()
+				}
+			}
+		}
+	}
+}
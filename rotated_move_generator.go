@@ -0,0 +1,48 @@
+package chessminimax
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// RotatedMoveGenerator ...
+//
+// It rotates moves generated by an inner generator to the left
+// by an offset, so different searchers can try moves in different orders
+// (e.g. workers of ParallelSearcher).
+type RotatedMoveGenerator struct {
+	innerGenerator MoveGenerator
+	offset         int
+}
+
+// NewRotatedMoveGenerator ...
+func NewRotatedMoveGenerator(
+	innerGenerator MoveGenerator,
+	offset int,
+) RotatedMoveGenerator {
+	return RotatedMoveGenerator{
+		innerGenerator: innerGenerator,
+		offset:         offset,
+	}
+}
+
+// MovesForColor ...
+func (generator RotatedMoveGenerator) MovesForColor(
+	storage models.PieceStorage,
+	color models.Color,
+) ([]models.Move, error) {
+	moveGroup, err := generator.innerGenerator.MovesForColor(storage, color)
+	if err != nil || len(moveGroup) == 0 {
+		return moveGroup, err
+	}
+
+	offset := generator.offset % len(moveGroup)
+	if offset < 0 {
+		offset += len(moveGroup)
+	}
+
+	rotatedMoves := make([]models.Move, 0, len(moveGroup))
+	rotatedMoves = append(rotatedMoves, moveGroup[offset:]...)
+	rotatedMoves = append(rotatedMoves, moveGroup[:offset]...)
+
+	return rotatedMoves, nil
+}
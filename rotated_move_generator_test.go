@@ -0,0 +1,167 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewRotatedMoveGenerator(test *testing.T) {
+	var innerGenerator MockMoveGenerator
+	generator := NewRotatedMoveGenerator(innerGenerator, 2)
+
+	if !reflect.DeepEqual(generator.innerGenerator, innerGenerator) {
+		test.Fail()
+	}
+	if generator.offset != 2 {
+		test.Fail()
+	}
+}
+
+func TestRotatedMoveGeneratorMovesForColor(test *testing.T) {
+	type fields struct {
+		innerGenerator MoveGenerator
+		offset         int
+	}
+	type args struct {
+		storage models.PieceStorage
+		color   models.Color
+	}
+	type data struct {
+		fields    fields
+		args      args
+		wantMoves []models.Move
+		wantErr   error
+	}
+
+	moveOne := models.Move{
+		Start:  models.Position{File: 1, Rank: 2},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+	moveTwo := models.Move{
+		Start:  models.Position{File: 5, Rank: 6},
+		Finish: models.Position{File: 7, Rank: 8},
+	}
+	moveThree := models.Move{
+		Start:  models.Position{File: 9, Rank: 10},
+		Finish: models.Position{File: 11, Rank: 12},
+	}
+	threeMoves := MockMoveGenerator{
+		movesForColor: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) ([]models.Move, error) {
+			if _, ok := storage.(MockPieceStorage); !ok {
+				test.Fail()
+			}
+			if color != models.White {
+				test.Fail()
+			}
+
+			return []models.Move{moveOne, moveTwo, moveThree}, nil
+		},
+	}
+	for _, data := range []data{
+		{
+			fields: fields{
+				innerGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						return nil, models.ErrKingCapture
+					},
+				},
+				offset: 1,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: nil,
+			wantErr:   models.ErrKingCapture,
+		},
+		{
+			fields: fields{
+				innerGenerator: MockMoveGenerator{
+					movesForColor: func(
+						storage models.PieceStorage,
+						color models.Color,
+					) ([]models.Move, error) {
+						return nil, nil
+					},
+				},
+				offset: 1,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: nil,
+			wantErr:   nil,
+		},
+		{
+			fields: fields{
+				innerGenerator: threeMoves,
+				offset:         0,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: []models.Move{moveOne, moveTwo, moveThree},
+			wantErr:   nil,
+		},
+		{
+			fields: fields{
+				innerGenerator: threeMoves,
+				offset:         1,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: []models.Move{moveTwo, moveThree, moveOne},
+			wantErr:   nil,
+		},
+		{
+			fields: fields{
+				innerGenerator: threeMoves,
+				offset:         5,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: []models.Move{moveThree, moveOne, moveTwo},
+			wantErr:   nil,
+		},
+		{
+			fields: fields{
+				innerGenerator: threeMoves,
+				offset:         -1,
+			},
+			args: args{
+				storage: MockPieceStorage{},
+				color:   models.White,
+			},
+			wantMoves: []models.Move{moveThree, moveOne, moveTwo},
+			wantErr:   nil,
+		},
+	} {
+		generator := RotatedMoveGenerator{
+			innerGenerator: data.fields.innerGenerator,
+			offset:         data.fields.offset,
+		}
+		gotMoves, gotErr :=
+			generator.MovesForColor(data.args.storage, data.args.color)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
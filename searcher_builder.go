@@ -0,0 +1,151 @@
+package chessminimax
+
+import (
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+)
+
+// SearcherBuilder ...
+//
+// It's a fluent API for making SearcherConfig and building a searcher by it.
+// Layers are added from the bottom to the top, so the builder starts
+// from an alpha-beta layer.
+type SearcherBuilder struct {
+	config SearcherConfig
+	clock  terminators.Clock
+}
+
+// NewSearcherBuilder ...
+func NewSearcherBuilder() *SearcherBuilder {
+	return &SearcherBuilder{
+		config: SearcherConfig{
+			Layers: []LayerConfig{{Type: AlphaBetaLayer}},
+		},
+	}
+}
+
+// WithEvaluator ...
+func (builder *SearcherBuilder) WithEvaluator(name string) *SearcherBuilder {
+	builder.config.Evaluator = name
+	return builder
+}
+
+// WithCache ...
+func (builder *SearcherBuilder) WithCache(
+	cacheType CacheType,
+	cacheSize int,
+) *SearcherBuilder {
+	return builder.withLayer(LayerConfig{
+		Type:      CacheLayer,
+		CacheType: cacheType,
+		CacheSize: cacheSize,
+	})
+}
+
+// WithIterativeDeepening ...
+//
+// Zero values of a deep schedule mean ones by default.
+func (builder *SearcherBuilder) WithIterativeDeepening(
+	initialDeep int,
+	deepStep int,
+) *SearcherBuilder {
+	return builder.withLayer(LayerConfig{
+		Type:        IterativeLayer,
+		InitialDeep: initialDeep,
+		DeepStep:    deepStep,
+	})
+}
+
+// WithConcurrency ...
+//
+// An empty selector means FirstResultSelectorName.
+func (builder *SearcherBuilder) WithConcurrency(
+	concurrency int,
+	selector string,
+) *SearcherBuilder {
+	return builder.withLayer(LayerConfig{
+		Type:        ParallelLayer,
+		Concurrency: concurrency,
+		Selector:    selector,
+	})
+}
+
+// WithMaximalDeep ...
+func (builder *SearcherBuilder) WithMaximalDeep(deep int) *SearcherBuilder {
+	return builder.withTerminator(TerminatorConfig{
+		Type: DeepTerminatorType,
+		Deep: deep,
+	})
+}
+
+// WithMaximalDuration ...
+func (builder *SearcherBuilder) WithMaximalDuration(
+	duration time.Duration,
+) *SearcherBuilder {
+	return builder.withTerminator(TerminatorConfig{
+		Type:     TimeTerminatorType,
+		Duration: ConfigDuration(duration),
+	})
+}
+
+// WithMaximalNodes ...
+func (builder *SearcherBuilder) WithMaximalNodes(nodes int) *SearcherBuilder {
+	return builder.withTerminator(TerminatorConfig{
+		Type:  NodeTerminatorType,
+		Nodes: nodes,
+	})
+}
+
+// WithStableIterations ...
+func (builder *SearcherBuilder) WithStableIterations(
+	iterations int,
+) *SearcherBuilder {
+	return builder.withTerminator(TerminatorConfig{
+		Type:       StabilityTerminatorType,
+		Iterations: iterations,
+	})
+}
+
+// WithClock ...
+//
+// It sets a clock for time terminators. A nil clock means time.Now().
+func (builder *SearcherBuilder) WithClock(
+	clock terminators.Clock,
+) *SearcherBuilder {
+	builder.clock = clock
+	return builder
+}
+
+// Config ...
+//
+// It returns a copy of the built config, e.g. for encoding it.
+func (builder *SearcherBuilder) Config() SearcherConfig {
+	config := builder.config
+	config.Layers = append([]LayerConfig(nil), config.Layers...)
+	config.Terminators =
+		append([]TerminatorConfig(nil), config.Terminators...)
+
+	return config
+}
+
+// Build ...
+//
+// See BuildSearcher().
+func (builder *SearcherBuilder) Build() (MoveSearcher, error) {
+	return BuildSearcher(builder.config, builder.clock)
+}
+
+func (builder *SearcherBuilder) withLayer(
+	layer LayerConfig,
+) *SearcherBuilder {
+	builder.config.Layers = append(builder.config.Layers, layer)
+	return builder
+}
+
+func (builder *SearcherBuilder) withTerminator(
+	terminator TerminatorConfig,
+) *SearcherBuilder {
+	builder.config.Terminators = append(builder.config.Terminators, terminator)
+	return builder
+}
@@ -0,0 +1,103 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearcherBuilderConfig(test *testing.T) {
+	builder := NewSearcherBuilder().
+		WithEvaluator(MaterialEvaluatorName).
+		WithCache(ParallelCacheType, 100).
+		WithIterativeDeepening(2, 1).
+		WithConcurrency(4, DeepestResultSelectorName).
+		WithMaximalDeep(5).
+		WithMaximalDuration(time.Second).
+		WithMaximalNodes(1000).
+		WithStableIterations(3)
+	gotConfig := builder.Config()
+
+	wantConfig := SearcherConfig{
+		Evaluator: MaterialEvaluatorName,
+		Layers: []LayerConfig{
+			{Type: AlphaBetaLayer},
+			{Type: CacheLayer, CacheType: ParallelCacheType, CacheSize: 100},
+			{Type: IterativeLayer, InitialDeep: 2, DeepStep: 1},
+			{
+				Type:        ParallelLayer,
+				Concurrency: 4,
+				Selector:    DeepestResultSelectorName,
+			},
+		},
+		Terminators: []TerminatorConfig{
+			{Type: DeepTerminatorType, Deep: 5},
+			{Type: TimeTerminatorType, Duration: ConfigDuration(time.Second)},
+			{Type: NodeTerminatorType, Nodes: 1000},
+			{Type: StabilityTerminatorType, Iterations: 3},
+		},
+	}
+	if !reflect.DeepEqual(gotConfig, wantConfig) {
+		test.Fail()
+	}
+
+	// the copy is independent
+	gotConfig.Layers[0].Type = ParallelLayer
+	if builder.config.Layers[0].Type != AlphaBetaLayer {
+		test.Fail()
+	}
+}
+
+func TestSearcherBuilderBuild(test *testing.T) {
+	clock := func() time.Time {
+		return time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	}
+	searcher, err := NewSearcherBuilder().
+		WithIterativeDeepening(0, 0).
+		WithMaximalDuration(time.Second).
+		WithClock(clock).
+		Build()
+
+	configuredSearcher, ok := searcher.(configuredSearcher)
+	if !ok {
+		test.FailNow()
+	}
+	iterativeSearcher, ok := configuredSearcher.MoveSearcher.(IterativeSearcher)
+	if !ok {
+		test.FailNow()
+	}
+	if iterativeSearcher.initialDeep != defaultInitialDeep {
+		test.Fail()
+	}
+	if iterativeSearcher.deepStep != defaultDeepStep {
+		test.Fail()
+	}
+	// the time terminator starts counting from the clock
+	if iterativeSearcher.terminator.SearchProgress(0) != 0 {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestSearcherBuilderBuild_withError(test *testing.T) {
+	searcher, err := NewSearcherBuilder().
+		WithConcurrency(2, "").
+		WithCache(StringHashingCacheType, 100).
+		WithMaximalDeep(2).
+		Build()
+
+	if searcher != nil {
+		test.Fail()
+	}
+
+	wantErr := ConfigError{
+		Field: "layers[1]",
+		Reason: "a parallel layer doesn't support " +
+			"setting an inner searcher, so it should be the last one",
+	}
+	if !reflect.DeepEqual(err, wantErr) {
+		test.Fail()
+	}
+}
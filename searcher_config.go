@@ -0,0 +1,525 @@
+package chessminimax
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// LayerType ...
+type LayerType string
+
+// ...
+const (
+	AlphaBetaLayer LayerType = "alpha_beta"
+	CacheLayer     LayerType = "cache"
+	IterativeLayer LayerType = "iterative"
+	ParallelLayer  LayerType = "parallel"
+)
+
+// CacheType ...
+type CacheType string
+
+// ...
+const (
+	// it isn't safe for concurrent use
+	StringHashingCacheType CacheType = "string_hashing"
+	// it's caches.StringHashingCache wrapped by caches.ParallelCache
+	ParallelCacheType CacheType = "parallel"
+)
+
+// TerminatorType ...
+type TerminatorType string
+
+// ...
+const (
+	DeepTerminatorType      TerminatorType = "deep"
+	TimeTerminatorType      TerminatorType = "time"
+	NodeTerminatorType      TerminatorType = "nodes"
+	StabilityTerminatorType TerminatorType = "stability"
+)
+
+// ...
+const (
+	MaterialEvaluatorName = "material"
+
+	FirstResultSelectorName   = "first"
+	DeepestResultSelectorName = "deepest"
+	VotedResultSelectorName   = "voted"
+)
+
+// ConfigDuration ...
+//
+// It's encoded as a string in the time.ParseDuration() format, e.g. "1.5s".
+// It implements the encoding.TextMarshaler and encoding.TextUnmarshaler
+// interfaces, so it's supported by most of encoding packages.
+type ConfigDuration time.Duration
+
+// LayerConfig ...
+//
+// Only fields that correspond to its type are used.
+type LayerConfig struct {
+	Type LayerType `json:"type" yaml:"type"`
+
+	// options of a cache layer;
+	// an empty cache type means StringHashingCacheType
+	CacheType CacheType `json:"cache_type,omitempty" yaml:"cache_type"`
+	CacheSize int       `json:"cache_size,omitempty" yaml:"cache_size"`
+
+	// options of an iterative layer;
+	// zero values mean a deep schedule by default
+	InitialDeep int `json:"initial_deep,omitempty" yaml:"initial_deep"`
+	DeepStep    int `json:"deep_step,omitempty" yaml:"deep_step"`
+
+	// options of a parallel layer;
+	// an empty selector means FirstResultSelectorName
+	Concurrency int    `json:"concurrency,omitempty" yaml:"concurrency"`
+	Selector    string `json:"selector,omitempty" yaml:"selector"`
+}
+
+// TerminatorConfig ...
+//
+// Only a field that corresponds to its type is used.
+type TerminatorConfig struct {
+	Type TerminatorType `json:"type" yaml:"type"`
+
+	Deep     int            `json:"deep,omitempty" yaml:"deep"`
+	Duration ConfigDuration `json:"duration,omitempty" yaml:"duration"`
+	Nodes    int            `json:"nodes,omitempty" yaml:"nodes"`
+	// a number of stable iterations
+	Iterations int `json:"iterations,omitempty" yaml:"iterations"`
+}
+
+// SearcherConfig ...
+//
+// It describes a stack of searchers from the bottom to the top. The stack
+// should start from an alpha-beta layer. A cache layer binds itself
+// to the alpha-beta searcher (see NewCachedSearcher()), so it should follow
+// the alpha-beta layer directly. A parallel layer doesn't support
+// an inner searcher (see ParallelSearcher.SetSearcher()), so it should be
+// the last one; layers below it are built for each its worker, except a cache,
+// which is shared between them.
+//
+// A search is terminated by a first fired terminator.
+//
+// An empty evaluator means MaterialEvaluatorName.
+type SearcherConfig struct {
+	Evaluator   string             `json:"evaluator,omitempty" yaml:"evaluator"`
+	Layers      []LayerConfig      `json:"layers" yaml:"layers"`
+	Terminators []TerminatorConfig `json:"terminators" yaml:"terminators"`
+}
+
+// ConfigError ...
+//
+// It describes a reason of invalidity of a field of SearcherConfig.
+type ConfigError struct {
+	Field  string
+	Reason string
+}
+
+// ParseSearcherConfig ...
+//
+// It decodes a config from JSON. Unknown fields are treated as errors.
+// It doesn't validate the config.
+func ParseSearcherConfig(data []byte) (SearcherConfig, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var config SearcherConfig
+	if err := decoder.Decode(&config); err != nil {
+		return SearcherConfig{}, err
+	}
+
+	return config, nil
+}
+
+// BuildSearcher ...
+//
+// It validates the config and builds a correctly wired searcher by it.
+// Terminators are created anew on each search, so time ones start counting
+// from a start of a search, and the searcher can be used repeatedly.
+// A terminator set by SetTerminator() is added to them.
+//
+// If the clock is nil, time.Now() is used.
+func BuildSearcher(
+	config SearcherConfig,
+	clock terminators.Clock,
+) (MoveSearcher, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+
+	// it's validated above, so it's always found
+	evaluator, _ := makeEvaluator(config.Evaluator)
+	terminator := makeConfigTerminator(config.Terminators, clock)
+
+	var cache caches.Cache
+	workerLayers := config.Layers
+	for _, layer := range config.Layers {
+		switch layer.Type {
+		case CacheLayer:
+			cache = makeConfigCache(layer)
+		case ParallelLayer:
+			// the parallel layer is validated to be the last one
+			workerLayers = workerLayers[:len(workerLayers)-1]
+
+			selector, _ := makeResultSelector(layer.Selector)
+			searcher := NewParallelSearcher(
+				terminator,
+				layer.Concurrency,
+				func(index int) MoveSearcher {
+					return buildWorker(workerLayers, index, evaluator, cache)
+				},
+				selector,
+			)
+			return newConfiguredSearcher(searcher, config.Terminators, clock), nil
+		}
+	}
+
+	searcher := buildWorker(workerLayers, 0, evaluator, cache)
+	searcher.SetTerminator(terminator)
+
+	return newConfiguredSearcher(searcher, config.Terminators, clock), nil
+}
+
+// it creates terminators by a config on each search, so their states
+// (e.g. a start time or a number of visited nodes) aren't carried over
+// between searches
+type configuredSearcher struct {
+	MoveSearcher
+
+	configs []TerminatorConfig
+	clock   terminators.Clock
+	// it's an additional terminator set by SetTerminator()
+	terminator *terminators.SearchTerminator
+}
+
+func newConfiguredSearcher(
+	searcher MoveSearcher,
+	configs []TerminatorConfig,
+	clock terminators.Clock,
+) configuredSearcher {
+	return configuredSearcher{
+		MoveSearcher: searcher,
+
+		configs:    configs,
+		clock:      clock,
+		terminator: new(terminators.SearchTerminator),
+	}
+}
+
+// SetTerminator ...
+//
+// The terminator is added to ones created by the config on each search.
+// A nil value resets it.
+func (searcher configuredSearcher) SetTerminator(
+	terminator terminators.SearchTerminator,
+) {
+	*searcher.terminator = terminator
+}
+
+// SetObserver ...
+//
+// It sets the observer to the inner searcher.
+func (searcher configuredSearcher) SetObserver(
+	observer observers.SearchObserver,
+) {
+	setObserver(searcher.MoveSearcher, observer)
+}
+
+// SearchMove ...
+func (searcher configuredSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	terminator := makeConfigTerminator(searcher.configs, searcher.clock)
+	if *searcher.terminator != nil {
+		terminator =
+			terminators.NewGroupTerminator(*searcher.terminator, terminator)
+	}
+
+	searcher.MoveSearcher.SetTerminator(terminator)
+	return searcher.MoveSearcher.SearchMove(storage, color, deep, bounds)
+}
+
+// Validate ...
+//
+// It returns ConfigError on a first found problem.
+func (config SearcherConfig) Validate() error {
+	if _, ok := makeEvaluator(config.Evaluator); !ok {
+		return ConfigError{Field: "evaluator", Reason: "unknown evaluator"}
+	}
+
+	if len(config.Layers) == 0 {
+		return ConfigError{Field: "layers", Reason: "no layers"}
+	}
+
+	hasIterativeLayer := false
+	for index, layer := range config.Layers {
+		reason := config.validateLayer(index, hasIterativeLayer)
+		if reason != "" {
+			field := fmt.Sprintf("layers[%d]", index)
+			return ConfigError{Field: field, Reason: reason}
+		}
+
+		if layer.Type == IterativeLayer {
+			hasIterativeLayer = true
+		}
+	}
+
+	if len(config.Terminators) == 0 {
+		return ConfigError{
+			Field:  "terminators",
+			Reason: "no terminators, so a search will never be terminated",
+		}
+	}
+
+	for index, terminator := range config.Terminators {
+		reason := validateTerminator(terminator, hasIterativeLayer)
+		if reason != "" {
+			field := fmt.Sprintf("terminators[%d]", index)
+			return ConfigError{Field: field, Reason: reason}
+		}
+	}
+
+	return nil
+}
+
+func (config SearcherConfig) validateLayer(
+	index int,
+	hasIterativeLayer bool,
+) string {
+	layer := config.Layers[index]
+	if index == 0 && layer.Type != AlphaBetaLayer {
+		return "a first layer should be an alpha-beta one"
+	}
+
+	isLast := index == len(config.Layers)-1
+	switch layer.Type {
+	case AlphaBetaLayer:
+		if index != 0 {
+			return "an alpha-beta layer should be the first one"
+		}
+	case CacheLayer:
+		if index != 1 {
+			return "a cache layer binds itself to an alpha-beta searcher, " +
+				"so it should directly follow an alpha-beta layer"
+		}
+		if layer.CacheSize <= 0 {
+			return "a cache size should be positive"
+		}
+
+		switch layer.CacheType {
+		case ParallelCacheType:
+		case "", StringHashingCacheType:
+			if config.concurrency() > 1 {
+				return "a cache of this type isn't safe for concurrent use " +
+					"by workers of a parallel layer"
+			}
+		default:
+			return "unknown cache type"
+		}
+	case IterativeLayer:
+		if hasIterativeLayer {
+			return "an iterative layer should be the only one"
+		}
+		if layer.InitialDeep < 0 || layer.DeepStep < 0 {
+			return "a deep schedule shouldn't be negative"
+		}
+	case ParallelLayer:
+		if !isLast {
+			return "a parallel layer doesn't support setting an inner searcher, " +
+				"so it should be the last one"
+		}
+		if layer.Concurrency <= 0 {
+			return "a concurrency should be positive"
+		}
+		if _, ok := makeResultSelector(layer.Selector); !ok {
+			return "unknown result selector"
+		}
+	default:
+		return "unknown layer type"
+	}
+
+	return ""
+}
+
+func (config SearcherConfig) concurrency() int {
+	for _, layer := range config.Layers {
+		if layer.Type == ParallelLayer {
+			return layer.Concurrency
+		}
+	}
+
+	return 1
+}
+
+// Error ...
+func (err ConfigError) Error() string {
+	return err.Field + ": " + err.Reason
+}
+
+// MarshalText ...
+func (duration ConfigDuration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(duration).String()), nil
+}
+
+// UnmarshalText ...
+func (duration *ConfigDuration) UnmarshalText(text []byte) error {
+	parsedDuration, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*duration = ConfigDuration(parsedDuration)
+	return nil
+}
+
+func validateTerminator(
+	terminator TerminatorConfig,
+	hasIterativeLayer bool,
+) string {
+	switch terminator.Type {
+	case DeepTerminatorType:
+		if terminator.Deep <= 0 {
+			return "a deep should be positive"
+		}
+	case TimeTerminatorType:
+		if terminator.Duration <= 0 {
+			return "a duration should be positive"
+		}
+	case NodeTerminatorType:
+		if terminator.Nodes <= 0 {
+			return "a number of nodes should be positive"
+		}
+	case StabilityTerminatorType:
+		if terminator.Iterations <= 0 {
+			return "a number of stable iterations should be positive"
+		}
+		if !hasIterativeLayer {
+			return "a stability terminator requires an iterative layer"
+		}
+	default:
+		return "unknown terminator type"
+	}
+
+	return ""
+}
+
+func makeEvaluator(name string) (evaluators.BoardEvaluator, bool) {
+	switch name {
+	case "", MaterialEvaluatorName:
+		return evaluators.MaterialEvaluator{}, true
+	default:
+		return nil, false
+	}
+}
+
+func makeResultSelector(name string) (ResultSelector, bool) {
+	switch name {
+	case "", FirstResultSelectorName:
+		return SelectFirstResult, true
+	case DeepestResultSelectorName:
+		return SelectDeepestResult, true
+	case VotedResultSelectorName:
+		return SelectVotedResult, true
+	default:
+		return nil, false
+	}
+}
+
+func makeConfigCache(layer LayerConfig) caches.Cache {
+	cache := caches.NewStringHashingCache(layer.CacheSize, uci.EncodePieceStorage)
+	if layer.CacheType != ParallelCacheType {
+		return cache
+	}
+
+	return caches.NewParallelCache(cache)
+}
+
+func makeConfigTerminator(
+	configs []TerminatorConfig,
+	clock terminators.Clock,
+) terminators.SearchTerminator {
+	var terminatorGroup []terminators.SearchTerminator
+	for _, config := range configs {
+		var terminator terminators.SearchTerminator
+		switch config.Type {
+		case DeepTerminatorType:
+			terminator = terminators.NewDeepTerminator(config.Deep)
+		case TimeTerminatorType:
+			terminator =
+				terminators.NewTimeTerminator(clock, time.Duration(config.Duration))
+		case NodeTerminatorType:
+			terminator = terminators.NewNodeTerminator(config.Nodes)
+		case StabilityTerminatorType:
+			terminator = terminators.NewStabilityTerminator(config.Iterations)
+		}
+
+		terminatorGroup = append(terminatorGroup, terminator)
+	}
+
+	return terminators.NewGroupTerminator(terminatorGroup...)
+}
+
+func buildWorker(
+	layers []LayerConfig,
+	index int,
+	evaluator evaluators.BoardEvaluator,
+	cache caches.Cache,
+) MoveSearcher {
+	var searcher MoveSearcher
+	for _, layer := range layers {
+		switch layer.Type {
+		case AlphaBetaLayer:
+			// try moves in different orders in different workers
+			generator := NewRotatedMoveGenerator(models.MoveGenerator{}, index)
+			searcher = NewAlphaBetaSearcher(
+				generator,
+				nil, // terminator will be set automatically by an outer searcher
+				evaluator,
+			)
+		case CacheLayer:
+			// make and bind a cached searcher to the alpha-beta one;
+			// a root isn't cached, so the alpha-beta searcher remains the outer one
+			NewCachedSearcher(searcher, cache)
+		case IterativeLayer:
+			iterativeSearcher := NewIterativeSearcher(
+				searcher,
+				nil, // terminator will be set automatically by an outer searcher
+			)
+			if layer.InitialDeep != 0 || layer.DeepStep != 0 {
+				iterativeSearcher.SetDeepSchedule(
+					defaultIfZero(layer.InitialDeep, defaultInitialDeep),
+					defaultIfZero(layer.DeepStep, defaultDeepStep),
+				)
+			}
+
+			searcher = iterativeSearcher
+		}
+	}
+
+	return searcher
+}
+
+func defaultIfZero(value int, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
@@ -0,0 +1,589 @@
+package chessminimax
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestParseSearcherConfig(test *testing.T) {
+	type data struct {
+		data       string
+		wantConfig SearcherConfig
+		wantErr    bool
+	}
+
+	for _, data := range []data{
+		{
+			data: `{
+				"evaluator": "material",
+				"layers": [
+					{"type": "alpha_beta"},
+					{"type": "cache", "cache_type": "parallel", "cache_size": 100},
+					{"type": "iterative", "initial_deep": 2},
+					{"type": "parallel", "concurrency": 4, "selector": "deepest"}
+				],
+				"terminators": [
+					{"type": "deep", "deep": 5},
+					{"type": "time", "duration": "1.5s"}
+				]
+			}`,
+			wantConfig: SearcherConfig{
+				Evaluator: MaterialEvaluatorName,
+				Layers: []LayerConfig{
+					{Type: AlphaBetaLayer},
+					{Type: CacheLayer, CacheType: ParallelCacheType, CacheSize: 100},
+					{Type: IterativeLayer, InitialDeep: 2},
+					{Type: ParallelLayer, Concurrency: 4, Selector: "deepest"},
+				},
+				Terminators: []TerminatorConfig{
+					{Type: DeepTerminatorType, Deep: 5},
+					{
+						Type:     TimeTerminatorType,
+						Duration: ConfigDuration(1500 * time.Millisecond),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			data:       `{"layers": [{"type": "alpha_beta", "unknown": 1}]}`,
+			wantConfig: SearcherConfig{},
+			wantErr:    true,
+		},
+		{
+			data:       `{"terminators": [{"type": "time", "duration": "incorrect"}]}`,
+			wantConfig: SearcherConfig{},
+			wantErr:    true,
+		},
+	} {
+		gotConfig, gotErr := ParseSearcherConfig([]byte(data.data))
+
+		if !reflect.DeepEqual(gotConfig, data.wantConfig) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestConfigDurationMarshalText(test *testing.T) {
+	config := TerminatorConfig{
+		Type:     TimeTerminatorType,
+		Duration: ConfigDuration(1500 * time.Millisecond),
+	}
+	data, err := json.Marshal(config)
+
+	wantData := `{"type":"time","duration":"1.5s"}`
+	if string(data) != wantData {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestSearcherConfigValidate(test *testing.T) {
+	alphaBetaLayer := LayerConfig{Type: AlphaBetaLayer}
+	cacheLayer := LayerConfig{Type: CacheLayer, CacheSize: 100}
+	iterativeLayer := LayerConfig{Type: IterativeLayer}
+	parallelLayer := LayerConfig{Type: ParallelLayer, Concurrency: 2}
+	deepTerminator := TerminatorConfig{Type: DeepTerminatorType, Deep: 2}
+
+	type data struct {
+		config  SearcherConfig
+		wantErr error
+	}
+
+	for _, data := range []data{
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					{Type: CacheLayer, CacheType: ParallelCacheType, CacheSize: 100},
+					iterativeLayer,
+					parallelLayer,
+				},
+				Terminators: []TerminatorConfig{
+					deepTerminator,
+					{Type: StabilityTerminatorType, Iterations: 3},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			config: SearcherConfig{
+				Evaluator:   "unknown",
+				Layers:      []LayerConfig{alphaBetaLayer},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{Field: "evaluator", Reason: "unknown evaluator"},
+		},
+		{
+			config: SearcherConfig{
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{Field: "layers", Reason: "no layers"},
+		},
+		{
+			config: SearcherConfig{
+				Layers:      []LayerConfig{iterativeLayer, alphaBetaLayer},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[0]",
+				Reason: "a first layer should be an alpha-beta one",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers:      []LayerConfig{alphaBetaLayer, alphaBetaLayer},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[1]",
+				Reason: "an alpha-beta layer should be the first one",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers:      []LayerConfig{alphaBetaLayer, iterativeLayer, cacheLayer},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field: "layers[2]",
+				Reason: "a cache layer binds itself to an alpha-beta searcher, " +
+					"so it should directly follow an alpha-beta layer",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					{Type: CacheLayer},
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[1]",
+				Reason: "a cache size should be positive",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					{Type: CacheLayer, CacheType: "unknown", CacheSize: 100},
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{Field: "layers[1]", Reason: "unknown cache type"},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					cacheLayer,
+					iterativeLayer,
+					parallelLayer,
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field: "layers[1]",
+				Reason: "a cache of this type isn't safe for concurrent use " +
+					"by workers of a parallel layer",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					iterativeLayer,
+					iterativeLayer,
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[2]",
+				Reason: "an iterative layer should be the only one",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					{Type: IterativeLayer, DeepStep: -1},
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[1]",
+				Reason: "a deep schedule shouldn't be negative",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					parallelLayer,
+					iterativeLayer,
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field: "layers[1]",
+				Reason: "a parallel layer doesn't support " +
+					"setting an inner searcher, so it should be the last one",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					{Type: ParallelLayer},
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[1]",
+				Reason: "a concurrency should be positive",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{
+					alphaBetaLayer,
+					{Type: ParallelLayer, Concurrency: 2, Selector: "unknown"},
+				},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{
+				Field:  "layers[1]",
+				Reason: "unknown result selector",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers:      []LayerConfig{alphaBetaLayer, {Type: "unknown"}},
+				Terminators: []TerminatorConfig{deepTerminator},
+			},
+			wantErr: ConfigError{Field: "layers[1]", Reason: "unknown layer type"},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{alphaBetaLayer},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators",
+				Reason: "no terminators, so a search will never be terminated",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{alphaBetaLayer},
+				Terminators: []TerminatorConfig{
+					deepTerminator,
+					{Type: DeepTerminatorType},
+				},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators[1]",
+				Reason: "a deep should be positive",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{alphaBetaLayer},
+				Terminators: []TerminatorConfig{
+					{Type: TimeTerminatorType},
+				},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators[0]",
+				Reason: "a duration should be positive",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{alphaBetaLayer},
+				Terminators: []TerminatorConfig{
+					{Type: NodeTerminatorType},
+				},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators[0]",
+				Reason: "a number of nodes should be positive",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{alphaBetaLayer, iterativeLayer},
+				Terminators: []TerminatorConfig{
+					{Type: StabilityTerminatorType},
+				},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators[0]",
+				Reason: "a number of stable iterations should be positive",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers: []LayerConfig{alphaBetaLayer},
+				Terminators: []TerminatorConfig{
+					{Type: StabilityTerminatorType, Iterations: 3},
+				},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators[0]",
+				Reason: "a stability terminator requires an iterative layer",
+			},
+		},
+		{
+			config: SearcherConfig{
+				Layers:      []LayerConfig{alphaBetaLayer},
+				Terminators: []TerminatorConfig{{Type: "unknown"}},
+			},
+			wantErr: ConfigError{
+				Field:  "terminators[0]",
+				Reason: "unknown terminator type",
+			},
+		},
+	} {
+		gotErr := data.config.Validate()
+
+		if !reflect.DeepEqual(gotErr, data.wantErr) {
+			test.Fail()
+		}
+	}
+}
+
+func TestConfigErrorError(test *testing.T) {
+	err := ConfigError{Field: "layers", Reason: "no layers"}
+	if err.Error() != "layers: no layers" {
+		test.Fail()
+	}
+}
+
+func TestBuildSearcher(test *testing.T) {
+	deepTerminator := TerminatorConfig{Type: DeepTerminatorType, Deep: 2}
+
+	test.Run("invalid", func(test *testing.T) {
+		searcher, err := BuildSearcher(SearcherConfig{}, nil)
+
+		if searcher != nil {
+			test.Fail()
+		}
+		wantErr := ConfigError{Field: "layers", Reason: "no layers"}
+		if !reflect.DeepEqual(err, wantErr) {
+			test.Fail()
+		}
+	})
+
+	test.Run("alpha-beta", func(test *testing.T) {
+		searcher, err := BuildSearcher(SearcherConfig{
+			Layers:      []LayerConfig{{Type: AlphaBetaLayer}},
+			Terminators: []TerminatorConfig{deepTerminator},
+		}, nil)
+
+		configuredSearcher, ok := searcher.(configuredSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		alphaBetaSearcher, ok :=
+			configuredSearcher.MoveSearcher.(AlphaBetaSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		// without a cache, it's bound to itself
+		if _, ok := alphaBetaSearcher.searcher.(AlphaBetaSearcher); !ok {
+			test.Fail()
+		}
+
+		wantTerminator := terminators.NewGroupTerminator(
+			terminators.NewDeepTerminator(2),
+		)
+		if !reflect.DeepEqual(alphaBetaSearcher.terminator, wantTerminator) {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	})
+
+	test.Run("cached iterative", func(test *testing.T) {
+		searcher, err := BuildSearcher(SearcherConfig{
+			Layers: []LayerConfig{
+				{Type: AlphaBetaLayer},
+				{Type: CacheLayer, CacheSize: 100},
+				{Type: IterativeLayer, InitialDeep: 2},
+			},
+			Terminators: []TerminatorConfig{deepTerminator},
+		}, nil)
+
+		configuredSearcher, ok := searcher.(configuredSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		iterativeSearcher, ok :=
+			configuredSearcher.MoveSearcher.(IterativeSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		if iterativeSearcher.initialDeep != 2 || iterativeSearcher.deepStep != 1 {
+			test.Fail()
+		}
+
+		// a root isn't cached
+		alphaBetaSearcher, ok := iterativeSearcher.searcher.(AlphaBetaSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		cachedSearcher, ok := alphaBetaSearcher.searcher.(CachedSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		if _, ok := cachedSearcher.cache.(caches.StringHashingCache); !ok {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	})
+
+	test.Run("parallel", func(test *testing.T) {
+		searcher, err := BuildSearcher(SearcherConfig{
+			Layers: []LayerConfig{
+				{Type: AlphaBetaLayer},
+				{Type: CacheLayer, CacheType: ParallelCacheType, CacheSize: 100},
+				{Type: IterativeLayer},
+				{Type: ParallelLayer, Concurrency: 2},
+			},
+			Terminators: []TerminatorConfig{deepTerminator},
+		}, nil)
+
+		configuredSearcher, ok := searcher.(configuredSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		parallelSearcher, ok :=
+			configuredSearcher.MoveSearcher.(ParallelSearcher)
+		if !ok {
+			test.FailNow()
+		}
+		if parallelSearcher.concurrency != 2 {
+			test.Fail()
+		}
+
+		// workers are independent, but share a cache
+		var workerCaches []caches.Cache
+		for index := 0; index < 2; index++ {
+			worker, ok := parallelSearcher.factory(index).(IterativeSearcher)
+			if !ok {
+				test.FailNow()
+			}
+			alphaBetaSearcher, ok := worker.searcher.(AlphaBetaSearcher)
+			if !ok {
+				test.FailNow()
+			}
+			cachedSearcher, ok := alphaBetaSearcher.searcher.(CachedSearcher)
+			if !ok {
+				test.FailNow()
+			}
+
+			workerCaches = append(workerCaches, cachedSearcher.cache)
+		}
+		if _, ok := workerCaches[0].(*caches.ParallelCache); !ok {
+			test.Fail()
+		}
+		if workerCaches[0] != workerCaches[1] {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	})
+}
+
+func TestBuildSearcher_withRepeatedSearches(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"7K/8/7q/8/8/8/7Q/k7",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	layers := []LayerConfig{{Type: AlphaBetaLayer}, {Type: IterativeLayer}}
+	deepTerminator := TerminatorConfig{Type: DeepTerminatorType, Deep: 3}
+
+	// a reference search measures a number of nodes required
+	// for completing it
+	referenceSearcher, err := BuildSearcher(SearcherConfig{
+		Layers:      layers,
+		Terminators: []TerminatorConfig{deepTerminator},
+	}, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	nodeTerminator := terminators.NewNodeTerminator(1e9)
+	referenceSearcher.SetTerminator(nodeTerminator)
+	wantMove, wantErr := referenceSearcher.SearchMove(
+		storage,
+		models.White,
+		0, // initial deep
+		moves.NewBounds(),
+	)
+
+	currentTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return currentTime }
+	searcher, err := BuildSearcher(SearcherConfig{
+		Layers: layers,
+		Terminators: []TerminatorConfig{
+			deepTerminator,
+			{Type: TimeTerminatorType, Duration: ConfigDuration(time.Second)},
+			{Type: NodeTerminatorType, Nodes: nodeTerminator.VisitedNodes()},
+			{Type: StabilityTerminatorType, Iterations: 5},
+		},
+	}, clock)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var gotMoves []moves.ScoredMove
+	for i := 0; i < 2; i++ {
+		// a time of a previous search shouldn't be counted
+		currentTime = currentTime.Add(time.Minute)
+
+		gotMove, gotErr := searcher.SearchMove(
+			storage,
+			models.White,
+			0, // initial deep
+			moves.NewBounds(),
+		)
+		gotMoves = append(gotMoves, gotMove)
+
+		// a quality depends on terminators, so it's ignored
+		if gotMove.Move != wantMove.Move || gotMove.Score != wantMove.Score {
+			test.Fail()
+		}
+		if gotErr != wantErr {
+			test.Fail()
+		}
+	}
+	if gotMoves[0] != gotMoves[1] {
+		test.Fail()
+	}
+}
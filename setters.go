@@ -2,6 +2,7 @@ package chessminimax
 
 import (
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
@@ -16,7 +17,8 @@ type MoveSearcher interface {
 	// It should return only following errors:
 	// * models.ErrKingCapture;
 	// * ErrCheckmate;
-	// * ErrDraw.
+	// * ErrDraw;
+	// * ErrNoAllowedMove (only on a root restricted by a root generator).
 	SearchMove(
 		storage models.PieceStorage,
 		color models.Color,
@@ -35,6 +37,10 @@ func (setter *SearcherSetter) SetSearcher(searcher MoveSearcher) {
 	setter.searcher = searcher
 }
 
+func (setter *SearcherSetter) innerSearcher() MoveSearcher {
+	return setter.searcher
+}
+
 // TerminatorSetter ...
 type TerminatorSetter struct {
 	terminator terminators.SearchTerminator
@@ -51,3 +57,92 @@ func (setter *TerminatorSetter) SetTerminator(
 func (setter *TerminatorSetter) SearchProgress(deep int) float64 {
 	return setter.terminator.SearchProgress(deep)
 }
+
+// RootGeneratorSetter ...
+type RootGeneratorSetter struct {
+	rootGenerator MoveGenerator
+}
+
+// SetRootGenerator ...
+//
+// It sets a generator that's used instead of a common one only on a root
+// of a search (i.e. when a deep is zero), e.g. FilteredMoveGenerator
+// for restricting a search to a subset of moves. A nil value resets it.
+func (setter *RootGeneratorSetter) SetRootGenerator(generator MoveGenerator) {
+	setter.rootGenerator = generator
+}
+
+func (setter *RootGeneratorSetter) isRootRestricted() bool {
+	return setter != nil && setter.rootGenerator != nil
+}
+
+// it checks, if a root of a search of the searcher is restricted
+// by a root generator
+func isRootRestricted(searcher MoveSearcher) bool {
+	restrictable, ok := searcher.(interface{ isRootRestricted() bool })
+	return ok && restrictable.isRootRestricted()
+}
+
+// DeepScheduleSetter ...
+type DeepScheduleSetter struct {
+	initialDeep int
+	deepStep    int
+}
+
+// SetDeepSchedule ...
+//
+// It sets a deep of a first iteration and an increment of a deep between
+// iterations, e.g. for staggering workers of ParallelSearcher.
+//
+// It panics, if the deep of the first iteration isn't positive, because then
+// a root would be only evaluated statically without a move. It also panics,
+// if the increment isn't positive, because then iterations would repeat
+// a same deep forever.
+func (setter *DeepScheduleSetter) SetDeepSchedule(
+	initialDeep int,
+	deepStep int,
+) {
+	if initialDeep < 1 {
+		panic("non-positive initial deep")
+	}
+	if deepStep <= 0 {
+		panic("non-positive deep step")
+	}
+
+	setter.initialDeep = initialDeep
+	setter.deepStep = deepStep
+}
+
+// ObservableSearcher ...
+type ObservableSearcher interface {
+	SetObserver(observer observers.SearchObserver)
+}
+
+// ObserverSetter ...
+//
+// Its zero value (including a nil pointer) uses observers.NoopObserver.
+type ObserverSetter struct {
+	observer observers.SearchObserver
+}
+
+// SetObserver ...
+//
+// A nil value resets it to observers.NoopObserver.
+func (setter *ObserverSetter) SetObserver(observer observers.SearchObserver) {
+	setter.observer = observer
+}
+
+func (setter *ObserverSetter) currentObserver() observers.SearchObserver {
+	if setter == nil || setter.observer == nil {
+		return observers.NoopObserver{}
+	}
+
+	return setter.observer
+}
+
+// it sets the observer to the searcher, if the latter supports it
+func setObserver(searcher MoveSearcher, observer observers.SearchObserver) {
+	if observable, ok := searcher.(ObservableSearcher); ok {
+		observable.SetObserver(observer)
+	}
+}
@@ -1,10 +1,12 @@
 package chessminimax
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
 	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
 	"github.com/thewizardplusplus/go-chess-minimax/terminators"
 	models "github.com/thewizardplusplus/go-chess-models"
 )
@@ -81,6 +83,55 @@ func (terminator MockSearchTerminator) SearchProgress(deep int) float64 {
 	return terminator.searchProgress(deep)
 }
 
+// it records events as strings
+type MockSearchObserver struct {
+	events *[]string
+}
+
+func (observer MockSearchObserver) IterationStarted(deep int) {
+	observer.record("IterationStarted(%d)", deep)
+}
+
+func (observer MockSearchObserver) IterationFinished(
+	deep int,
+	move moves.FailedMove,
+) {
+	observer.record("IterationFinished(%d, %v)", deep, move.Move.Score)
+}
+
+func (observer MockSearchObserver) RootMoveStarted(
+	move models.Move,
+	number int,
+) {
+	observer.record("RootMoveStarted(%d, %d)", move.Start.File, number)
+}
+
+func (observer MockSearchObserver) BestRootMoveChanged(
+	move moves.ScoredMove,
+) {
+	observer.record(
+		"BestRootMoveChanged(%d, %v)",
+		move.Move.Start.File,
+		move.Score,
+	)
+}
+
+func (observer MockSearchObserver) CacheHit(deep int) {
+	observer.record("CacheHit(%d)", deep)
+}
+
+func (observer MockSearchObserver) Cutoff(deep int, move models.Move) {
+	observer.record("Cutoff(%d, %d)", deep, move.Start.File)
+}
+
+func (observer MockSearchObserver) record(
+	format string,
+	arguments ...interface{},
+) {
+	event := fmt.Sprintf(format, arguments...)
+	*observer.events = append(*observer.events, event)
+}
+
 func TestSearcherSetterSetSearcher(test *testing.T) {
 	var searcher MockMoveSearcher
 	var setter SearcherSetter
@@ -100,3 +151,89 @@ func TestTerminatorSetterSetTerminator(test *testing.T) {
 		test.Fail()
 	}
 }
+
+func TestRootGeneratorSetterSetRootGenerator(test *testing.T) {
+	var generator MockMoveGenerator
+	var setter RootGeneratorSetter
+	setter.SetRootGenerator(generator)
+
+	if !reflect.DeepEqual(setter.rootGenerator, generator) {
+		test.Fail()
+	}
+}
+
+func TestDeepScheduleSetterSetDeepSchedule(test *testing.T) {
+	var setter DeepScheduleSetter
+	setter.SetDeepSchedule(2, 3)
+
+	if setter.initialDeep != 2 {
+		test.Fail()
+	}
+	if setter.deepStep != 3 {
+		test.Fail()
+	}
+}
+
+func TestDeepScheduleSetterSetDeepSchedule_withNonPositiveInitialDeep(
+	test *testing.T,
+) {
+	for _, initialDeep := range []int{0, -1} {
+		var err interface{}
+		func() {
+			defer func() { err = recover() }()
+
+			var setter DeepScheduleSetter
+			setter.SetDeepSchedule(initialDeep, 2)
+		}()
+
+		if err != "non-positive initial deep" {
+			test.Fail()
+		}
+	}
+}
+
+func TestDeepScheduleSetterSetDeepSchedule_withNonPositiveStep(
+	test *testing.T,
+) {
+	for _, deepStep := range []int{0, -1} {
+		var err interface{}
+		func() {
+			defer func() { err = recover() }()
+
+			var setter DeepScheduleSetter
+			setter.SetDeepSchedule(2, deepStep)
+		}()
+
+		if err != "non-positive deep step" {
+			test.Fail()
+		}
+	}
+}
+
+func TestObserverSetterSetObserver(test *testing.T) {
+	observer := MockSearchObserver{events: new([]string)}
+	var setter ObserverSetter
+	setter.SetObserver(observer)
+
+	if !reflect.DeepEqual(setter.observer, observer) {
+		test.Fail()
+	}
+}
+
+func TestObserverSetterCurrentObserver(test *testing.T) {
+	var nilSetter *ObserverSetter
+	if _, ok := nilSetter.currentObserver().(observers.NoopObserver); !ok {
+		test.Fail()
+	}
+
+	var setter ObserverSetter
+	if _, ok := setter.currentObserver().(observers.NoopObserver); !ok {
+		test.Fail()
+	}
+
+	observer := MockSearchObserver{events: new([]string)}
+	setter.SetObserver(observer)
+	if !reflect.DeepEqual(setter.currentObserver(), observer) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,291 @@
+package chessminimax
+
+import (
+	"math"
+	"math/rand"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// RootMoveScorer ...
+//
+// It's implemented by searchers that can score all moves on a root
+// of a search, e.g. AlphaBetaSearcher.
+type RootMoveScorer interface {
+	MoveSearcher
+
+	ScoreMoves(
+		storage models.PieceStorage,
+		color models.Color,
+	) ([]moves.ScoredMove, error)
+}
+
+// SkillLevel ...
+//
+// Scores are measured in units of the evaluator (e.g. pawns
+// for evaluators.MaterialEvaluator).
+type SkillLevel struct {
+	// if it's zero, a deep isn't limited by a level
+	MaximalDeep int
+	// it limits a number of nodes of a search of every move on a root
+	// separately, so all of them are scored with a same budget;
+	// if it's zero, a number of nodes isn't limited by a level
+	MaximalNodes int
+
+	// it's a temperature of a random selection among near-best moves:
+	// a probability of a move is proportional to exp(-loss / temperature),
+	// where a loss is a difference between scores of a best move and this one;
+	// if it's zero, a best move is always selected
+	Temperature float64
+
+	// it's a probability of a controlled blunder, i.e. of a uniform selection
+	// among moves, which lose more than zero and at most the margin
+	BlunderProbability float64
+	BlunderMargin      float64
+}
+
+// ...
+const (
+	MinimalElo = 800
+	MaximalElo = 2000
+)
+
+// nolint: gochecknoglobals
+var skillLevels = []struct {
+	elo   int
+	level SkillLevel
+}{
+	{
+		elo: MinimalElo,
+		level: SkillLevel{
+			MaximalDeep:        1,
+			MaximalNodes:       500,
+			Temperature:        3,
+			BlunderProbability: 0.3,
+			BlunderMargin:      5,
+		},
+	},
+	{
+		elo: 1000,
+		level: SkillLevel{
+			MaximalDeep:        2,
+			MaximalNodes:       2000,
+			Temperature:        2,
+			BlunderProbability: 0.2,
+			BlunderMargin:      3,
+		},
+	},
+	{
+		elo: 1200,
+		level: SkillLevel{
+			MaximalDeep:        2,
+			MaximalNodes:       10000,
+			Temperature:        1,
+			BlunderProbability: 0.1,
+			BlunderMargin:      3,
+		},
+	},
+	{
+		elo: 1400,
+		level: SkillLevel{
+			MaximalDeep:        3,
+			Temperature:        0.5,
+			BlunderProbability: 0.05,
+			BlunderMargin:      2,
+		},
+	},
+	{
+		elo: 1600,
+		level: SkillLevel{
+			MaximalDeep:        3,
+			Temperature:        0.25,
+			BlunderProbability: 0.02,
+			BlunderMargin:      1,
+		},
+	},
+	{
+		elo:   1800,
+		level: SkillLevel{MaximalDeep: 4, Temperature: 0.1},
+	},
+	{
+		elo:   MaximalElo,
+		level: SkillLevel{MaximalDeep: 4},
+	},
+}
+
+// SkillLevelForElo ...
+//
+// It returns a level of a greatest tabulated Elo-like rating that doesn't
+// exceed the passed one. Ratings are clamped to [MinimalElo, MaximalElo].
+func SkillLevelForElo(elo int) SkillLevel {
+	level := skillLevels[0].level
+	for _, skillLevel := range skillLevels {
+		if skillLevel.elo > elo {
+			break
+		}
+
+		level = skillLevel.level
+	}
+
+	return level
+}
+
+// SkillSearcher ...
+//
+// It limits a strength of a search: it scores all moves on a root
+// by the scorer within limits of a skill level and selects one of them
+// randomly by the level.
+//
+// It's intended only for a root of a search, so it ignores the deep
+// and the bounds.
+//
+// Its randomizer is seeded, so its results are deterministic for tests.
+// It isn't safe for concurrent use.
+type SkillSearcher struct {
+	*TerminatorSetter
+
+	scorer     RootMoveScorer
+	level      SkillLevel
+	randomizer *rand.Rand
+}
+
+// NewSkillSearcher ...
+//
+// The terminator is grouped with limits of the level; if it hasn't any,
+// the terminator should limit a search by itself.
+func NewSkillSearcher(
+	scorer RootMoveScorer,
+	terminator terminators.SearchTerminator,
+	level SkillLevel,
+	seed int64,
+) SkillSearcher {
+	searcher := SkillSearcher{
+		TerminatorSetter: new(TerminatorSetter),
+
+		scorer:     scorer,
+		level:      level,
+		randomizer: rand.New(rand.NewSource(seed)),
+	}
+
+	searcher.SetTerminator(terminator)
+
+	return searcher
+}
+
+// SetSearcher ...
+//
+// It does nothing and is required only for correspondence
+// to the MoveSearcher interface.
+//
+// It always panics.
+func (SkillSearcher) SetSearcher(innerSearcher MoveSearcher) {
+	panic("not supported")
+}
+
+// SearchMove ...
+func (searcher SkillSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	// limits of a level are stateful, so they are made for every search
+	searcher.scorer.SetTerminator(searcher.makeTerminator())
+
+	scoredMoves, err := searcher.scorer.ScoreMoves(storage, color)
+	if err != nil {
+		var score float64
+		if err == ErrCheckmate {
+			score = evaluateCheckmate(0)
+		}
+
+		return moves.ScoredMove{Score: score}, err
+	}
+
+	return searcher.selectMove(scoredMoves), nil
+}
+
+func (searcher SkillSearcher) makeTerminator() terminators.SearchTerminator {
+	terminatorGroup := []terminators.SearchTerminator{searcher.terminator}
+	if searcher.level.MaximalDeep != 0 {
+		terminatorGroup = append(
+			terminatorGroup,
+			terminators.NewDeepTerminator(searcher.level.MaximalDeep),
+		)
+	}
+	if searcher.level.MaximalNodes != 0 {
+		nodeTerminator :=
+			terminators.NewNodeTerminator(searcher.level.MaximalNodes)
+		terminatorGroup = append(
+			terminatorGroup,
+			rootMoveNodeTerminator{NodeTerminator: nodeTerminator},
+		)
+	}
+
+	return terminators.NewGroupTerminator(terminatorGroup...)
+}
+
+// it limits a number of nodes of a search of every move on a root separately,
+// because RootMoveScorer scores them one by one and moves cut off by a shared
+// budget would get only static scores
+type rootMoveNodeTerminator struct {
+	*terminators.NodeTerminator
+}
+
+func (terminator rootMoveNodeTerminator) CountNode(deep int) {
+	// a node on a first deep starts a search of a next move on a root
+	if deep == 1 {
+		terminator.Reset()
+	}
+
+	terminator.NodeTerminator.CountNode(deep)
+}
+
+// the moves should be sorted by their scores in a descending order
+func (searcher SkillSearcher) selectMove(
+	scoredMoves []moves.ScoredMove,
+) moves.ScoredMove {
+	bestScore := scoredMoves[0].Score
+	if searcher.level.BlunderProbability > 0 &&
+		searcher.randomizer.Float64() < searcher.level.BlunderProbability {
+		var blunders []moves.ScoredMove
+		for _, scoredMove := range scoredMoves {
+			loss := bestScore - scoredMove.Score
+			if loss > 0 && loss <= searcher.level.BlunderMargin {
+				blunders = append(blunders, scoredMove)
+			}
+		}
+
+		if len(blunders) != 0 {
+			return blunders[searcher.randomizer.Intn(len(blunders))]
+		}
+	}
+
+	if searcher.level.Temperature <= 0 {
+		return scoredMoves[0]
+	}
+
+	// a weight of a best move is one, so a total weight is positive;
+	// weights of moves that are much worse (e.g. leading to a checkmate)
+	// are zeros
+	weights := make([]float64, len(scoredMoves))
+	var totalWeight float64
+	for index, scoredMove := range scoredMoves {
+		loss := bestScore - scoredMove.Score
+		weights[index] = math.Exp(-loss / searcher.level.Temperature)
+		totalWeight += weights[index]
+	}
+
+	threshold := searcher.randomizer.Float64() * totalWeight
+	for index, weight := range weights {
+		threshold -= weight
+		if threshold < 0 {
+			return scoredMoves[index]
+		}
+	}
+
+	// it's possible only because of rounding errors
+	return scoredMoves[len(scoredMoves)-1]
+}
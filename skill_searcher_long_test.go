@@ -0,0 +1,158 @@
+// +build long
+
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestAlphaBetaSearcherScoreMoves_withBoard(test *testing.T) {
+	type args struct {
+		boardInFEN  string
+		color       models.Color
+		maximalDeep int
+	}
+
+	for _, args := range []args{
+		// draw with checks on a third ply
+		{
+			boardInFEN:  "7K/6P1/8/2q5/8/8/b7/kb2B3",
+			color:       models.White,
+			maximalDeep: 3,
+		},
+		// checkmate on a second ply
+		{
+			boardInFEN:  "6K1/8/7q/6p1/8/2B5/pp4PQ/k7",
+			color:       models.White,
+			maximalDeep: 2,
+		},
+		// single profitable move on a first ply
+		{
+			boardInFEN:  "7K/8/7q/8/8/8/7Q/k7",
+			color:       models.White,
+			maximalDeep: 1,
+		},
+		// single profitable move on a third ply
+		{
+			boardInFEN:  "kn6/n6q/PP6/8/8/8/7P/7K",
+			color:       models.White,
+			maximalDeep: 3,
+		},
+		// checkmate on a first ply
+		{
+			boardInFEN:  "6BK/8/8/8/8/pp6/k6R/7R",
+			color:       models.Black,
+			maximalDeep: 1,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(args.boardInFEN, pieces.NewPiece, models.NewBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var generator models.MoveGenerator
+		var evaluator evaluators.MaterialEvaluator
+		terminator := terminators.NewDeepTerminator(args.maximalDeep)
+		searcher := NewAlphaBetaSearcher(generator, terminator, evaluator)
+		gotMoves, gotErr := searcher.ScoreMoves(storage, args.color)
+
+		// a best one of scored moves is the same as a found one
+		wantMove, wantErr :=
+			searcher.SearchMove(storage, args.color, 0, moves.NewBounds())
+		if wantErr != nil {
+			if gotMoves != nil {
+				test.Fail()
+			}
+			if gotErr != wantErr {
+				test.Fail()
+			}
+
+			continue
+		}
+
+		if len(gotMoves) == 0 || !reflect.DeepEqual(gotMoves[0], wantMove) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestSkillSearcher(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"7K/8/7q/8/8/8/7Q/k7",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	scorer := NewAlphaBetaSearcher(
+		generator,
+		nil, // terminator will be set automatically by the skill searcher
+		evaluator,
+	)
+
+	// a strongest level always captures the queen
+	searcher := NewSkillSearcher(
+		scorer,
+		terminators.NewDeepTerminator(10),
+		SkillLevelForElo(MaximalElo),
+		23,
+	)
+	for i := 0; i < 5; i++ {
+		gotMove, gotErr :=
+			searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+
+		wantMove := models.Move{
+			Start:  models.Position{File: 7, Rank: 1},
+			Finish: models.Position{File: 7, Rank: 5},
+		}
+		if gotMove.Move != wantMove || gotMove.Score != 9 {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+
+	// a weakest level is deterministic under a seed
+	var selections [][]models.Move
+	for i := 0; i < 2; i++ {
+		searcher := NewSkillSearcher(
+			scorer,
+			terminators.NewDeepTerminator(10),
+			SkillLevelForElo(MinimalElo),
+			23,
+		)
+
+		var selection []models.Move
+		for j := 0; j < 5; j++ {
+			gotMove, gotErr :=
+				searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+			if gotErr != nil {
+				test.Fail()
+			}
+
+			selection = append(selection, gotMove.Move)
+		}
+
+		selections = append(selections, selection)
+	}
+	if !reflect.DeepEqual(selections[0], selections[1]) {
+		test.Fail()
+	}
+}
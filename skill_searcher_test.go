@@ -0,0 +1,344 @@
+package chessminimax
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+type MockRootMoveScorer struct {
+	MockMoveSearcher
+
+	scoreMoves func(
+		storage models.PieceStorage,
+		color models.Color,
+	) ([]moves.ScoredMove, error)
+}
+
+func (scorer MockRootMoveScorer) ScoreMoves(
+	storage models.PieceStorage,
+	color models.Color,
+) ([]moves.ScoredMove, error) {
+	if scorer.scoreMoves == nil {
+		panic("not implemented")
+	}
+
+	return scorer.scoreMoves(storage, color)
+}
+
+func makeScoredMoves(scores ...float64) []moves.ScoredMove {
+	var scoredMoves []moves.ScoredMove
+	for index, score := range scores {
+		scoredMoves = append(scoredMoves, moves.ScoredMove{
+			Move:    models.Move{Start: models.Position{File: index}},
+			Score:   score,
+			Quality: 1,
+		})
+	}
+
+	return scoredMoves
+}
+
+func TestSkillLevelForElo(test *testing.T) {
+	type data struct {
+		elo       int
+		wantLevel SkillLevel
+	}
+
+	for _, data := range []data{
+		{elo: 0, wantLevel: skillLevels[0].level},
+		{elo: MinimalElo, wantLevel: skillLevels[0].level},
+		{elo: 1100, wantLevel: skillLevels[1].level},
+		{elo: 1200, wantLevel: skillLevels[2].level},
+		{elo: MaximalElo, wantLevel: skillLevels[len(skillLevels)-1].level},
+		{elo: 3000, wantLevel: skillLevels[len(skillLevels)-1].level},
+	} {
+		gotLevel := SkillLevelForElo(data.elo)
+
+		if !reflect.DeepEqual(gotLevel, data.wantLevel) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSkillLevels(test *testing.T) {
+	for index := 1; index < len(skillLevels); index++ {
+		previousLevel, level := skillLevels[index-1], skillLevels[index]
+		if previousLevel.elo >= level.elo {
+			test.Fail()
+		}
+		if previousLevel.level.MaximalDeep > level.level.MaximalDeep {
+			test.Fail()
+		}
+		if previousLevel.level.Temperature < level.level.Temperature {
+			test.Fail()
+		}
+		if previousLevel.level.BlunderProbability <
+			level.level.BlunderProbability {
+			test.Fail()
+		}
+	}
+}
+
+func TestNewSkillSearcher(test *testing.T) {
+	var scorer MockRootMoveScorer
+	var terminator MockSearchTerminator
+	level := SkillLevel{MaximalDeep: 2, Temperature: 1}
+	searcher := NewSkillSearcher(scorer, terminator, level, 23)
+
+	if !reflect.DeepEqual(searcher.scorer, scorer) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.terminator, terminator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.level, level) {
+		test.Fail()
+	}
+	if searcher.randomizer == nil {
+		test.Fail()
+	}
+}
+
+func TestSkillSearcherSetSearcher(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		var innerSearcher MockMoveSearcher
+		var searcher SkillSearcher
+		searcher.SetSearcher(innerSearcher)
+	}()
+
+	if err == nil {
+		test.Fail()
+	}
+}
+
+func TestSkillSearcherSearchMove(test *testing.T) {
+	type data struct {
+		scoredMoves []moves.ScoredMove
+		scoreErr    error
+		wantMove    moves.ScoredMove
+		wantErr     error
+	}
+
+	for _, data := range []data{
+		{
+			scoredMoves: makeScoredMoves(3, 1, -2),
+			scoreErr:    nil,
+			wantMove:    makeScoredMoves(3)[0],
+			wantErr:     nil,
+		},
+		{
+			scoredMoves: nil,
+			scoreErr:    ErrCheckmate,
+			wantMove:    moves.ScoredMove{Score: evaluateCheckmate(0)},
+			wantErr:     ErrCheckmate,
+		},
+		{
+			scoredMoves: nil,
+			scoreErr:    ErrDraw,
+			wantMove:    moves.ScoredMove{},
+			wantErr:     ErrDraw,
+		},
+	} {
+		var gotTerminator terminators.SearchTerminator
+		scorer := MockRootMoveScorer{
+			MockMoveSearcher: MockMoveSearcher{
+				setTerminator: func(terminator terminators.SearchTerminator) {
+					gotTerminator = terminator
+				},
+			},
+			scoreMoves: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) ([]moves.ScoredMove, error) {
+				if _, ok := storage.(MockPieceStorage); !ok {
+					test.Fail()
+				}
+				if color != models.White {
+					test.Fail()
+				}
+
+				return data.scoredMoves, data.scoreErr
+			},
+		}
+		terminator := terminators.NewDeepTerminator(10)
+		level := SkillLevel{MaximalDeep: 2, MaximalNodes: 100}
+		searcher := NewSkillSearcher(scorer, terminator, level, 23)
+		gotMove, gotErr := searcher.SearchMove(
+			MockPieceStorage{},
+			models.White,
+			0,
+			moves.NewBounds(),
+		)
+
+		wantTerminator := terminators.NewGroupTerminator(
+			terminator,
+			terminators.NewDeepTerminator(2),
+			rootMoveNodeTerminator{
+				NodeTerminator: terminators.NewNodeTerminator(100),
+			},
+		)
+		if !reflect.DeepEqual(gotTerminator, wantTerminator) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestSkillSearcherSearchMove_withNodeLimit(test *testing.T) {
+	generator := MockMoveGenerator{
+		movesForColor: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) ([]models.Move, error) {
+			moveGroup := []models.Move{
+				{Start: models.Position{File: 0}},
+				{Start: models.Position{File: 1}},
+			}
+			if storage.(MockTreeStorage).path == "" {
+				moveGroup =
+					append(moveGroup, models.Move{Start: models.Position{File: 2}})
+			}
+
+			return moveGroup, nil
+		},
+	}
+	evaluator := MockBoardEvaluator{
+		evaluateBoard: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) float64 {
+			// static scores of moves on a root are zeros
+			scores := map[string]float64{
+				"aa": 1,
+				"ab": 1,
+				"ba": 2,
+				"bb": 2,
+				"ca": 3,
+				"cb": 3,
+			}
+			return scores[storage.(MockTreeStorage).path]
+		},
+	}
+	scorer := NewAlphaBetaSearcher(generator, nil, evaluator)
+
+	// a search of every move on a root visits three nodes, so a shared budget
+	// would be exhausted after a first one
+	level := SkillLevel{MaximalDeep: 2, MaximalNodes: 3}
+	terminator := terminators.NewDeepTerminator(10)
+	searcher := NewSkillSearcher(scorer, terminator, level, 23)
+	gotMove, gotErr := searcher.SearchMove(
+		MockTreeStorage{},
+		models.White,
+		0,
+		moves.NewBounds(),
+	)
+
+	wantMove := moves.ScoredMove{
+		Move:    models.Move{Start: models.Position{File: 2}},
+		Score:   3,
+		Quality: 1,
+	}
+	if !reflect.DeepEqual(gotMove, wantMove) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+func TestSkillSearcherSelectMove(test *testing.T) {
+	type data struct {
+		level       SkillLevel
+		scoredMoves []moves.ScoredMove
+		// indices of moves, which can be selected
+		wantMoves map[int]bool
+	}
+
+	for _, data := range []data{
+		// without a temperature
+		{
+			level:       SkillLevel{},
+			scoredMoves: makeScoredMoves(3, 3, 1),
+			wantMoves:   map[int]bool{0: true},
+		},
+		// with a temperature
+		{
+			level:       SkillLevel{Temperature: 1},
+			scoredMoves: makeScoredMoves(3, 2.5, 2, evaluateCheckmate(1)),
+			wantMoves:   map[int]bool{0: true, 1: true, 2: true},
+		},
+		// with blunders
+		{
+			level: SkillLevel{BlunderProbability: 1, BlunderMargin: 2},
+			scoredMoves: makeScoredMoves(
+				3,
+				3,
+				2,
+				1,
+				-5,
+				evaluateCheckmate(1),
+			),
+			wantMoves: map[int]bool{2: true, 3: true},
+		},
+		// without suitable blunders
+		{
+			level:       SkillLevel{BlunderProbability: 1, BlunderMargin: 2},
+			scoredMoves: makeScoredMoves(3, 3, -5),
+			wantMoves:   map[int]bool{0: true},
+		},
+	} {
+		searcher :=
+			NewSkillSearcher(MockRootMoveScorer{}, nil, data.level, 23)
+
+		gotMoves := make(map[int]bool)
+		for i := 0; i < 100; i++ {
+			move := searcher.selectMove(data.scoredMoves)
+			gotMoves[move.Move.Start.File] = true
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSkillSearcherSelectMove_withSeed(test *testing.T) {
+	level := SkillLevel{
+		Temperature:        1,
+		BlunderProbability: 0.2,
+		BlunderMargin:      3,
+	}
+	scoredMoves := makeScoredMoves(3, 2.5, 2, 1, 0)
+
+	var selections [][]int
+	for _, seed := range []int64{23, 23, 42} {
+		searcher := NewSkillSearcher(MockRootMoveScorer{}, nil, level, seed)
+
+		var selection []int
+		for i := 0; i < 20; i++ {
+			move := searcher.selectMove(scoredMoves)
+			selection = append(selection, move.Move.Start.File)
+		}
+
+		selections = append(selections, selection)
+	}
+
+	if !reflect.DeepEqual(selections[0], selections[1]) {
+		test.Fail()
+	}
+	if reflect.DeepEqual(selections[0], selections[2]) {
+		test.Fail()
+	}
+}
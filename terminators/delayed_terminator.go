@@ -0,0 +1,59 @@
+package terminators
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// DelayedTerminator ...
+//
+// It doesn't terminate a search at deeps not greater than the minimal one,
+// and then it fires together with its inner terminator. So it guarantees
+// that IterativeSearcher completes iterations up to the minimal deep.
+//
+// It supports the IterationTerminator interface in the same way. Visited nodes
+// are forwarded to its inner terminator at any deep.
+type DelayedTerminator struct {
+	minimalDeep int
+	terminator  SearchTerminator
+}
+
+// NewDelayedTerminator ...
+func NewDelayedTerminator(
+	minimalDeep int,
+	terminator SearchTerminator,
+) DelayedTerminator {
+	return DelayedTerminator{
+		minimalDeep: minimalDeep,
+		terminator:  terminator,
+	}
+}
+
+// IsSearchTerminated ...
+func (terminator DelayedTerminator) IsSearchTerminated(deep int) bool {
+	return deep > terminator.minimalDeep &&
+		terminator.terminator.IsSearchTerminated(deep)
+}
+
+// SearchProgress ...
+func (terminator DelayedTerminator) SearchProgress(deep int) float64 {
+	return terminator.terminator.SearchProgress(deep)
+}
+
+// CountNode ...
+func (terminator DelayedTerminator) CountNode(deep int) {
+	CountNode(terminator.terminator, deep)
+}
+
+// IsIterationTerminated ...
+func (terminator DelayedTerminator) IsIterationTerminated(deep int) bool {
+	return deep > terminator.minimalDeep &&
+		IsIterationTerminated(terminator.terminator, deep)
+}
+
+// CompleteIteration ...
+func (terminator DelayedTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	CompleteIteration(terminator.terminator, deep, move)
+}
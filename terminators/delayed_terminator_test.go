@@ -0,0 +1,76 @@
+package terminators
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+func TestNewDelayedTerminator(test *testing.T) {
+	var innerTerminator MockSearchTerminator
+	terminator := NewDelayedTerminator(4, innerTerminator)
+
+	if terminator.minimalDeep != 4 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(terminator.terminator, innerTerminator) {
+		test.Fail()
+	}
+}
+
+func TestDelayedTerminator(test *testing.T) {
+	var completedDeeps []int
+	terminator := DelayedTerminator{
+		minimalDeep: 4,
+		terminator: MockIterationTerminator{
+			MockSearchTerminator: MockSearchTerminator{
+				isSearchTerminated: func(deep int) bool { return true },
+				searchProgress:     func(deep int) float64 { return 0.25 },
+			},
+			isIterationTerminated: func(deep int) bool { return true },
+			completeIteration: func(deep int, move moves.FailedMove) {
+				completedDeeps = append(completedDeeps, deep)
+			},
+		},
+	}
+
+	for deep := 0; deep <= 4; deep++ {
+		if terminator.IsSearchTerminated(deep) {
+			test.Fail()
+		}
+		if terminator.IsIterationTerminated(deep) {
+			test.Fail()
+		}
+	}
+	if !terminator.IsSearchTerminated(5) {
+		test.Fail()
+	}
+	if !terminator.IsIterationTerminated(5) {
+		test.Fail()
+	}
+	if terminator.SearchProgress(2) != 0.25 {
+		test.Fail()
+	}
+
+	terminator.CompleteIteration(2, moves.FailedMove{})
+	if !reflect.DeepEqual(completedDeeps, []int{2}) {
+		test.Fail()
+	}
+}
+
+func TestDelayedTerminatorCountNode(test *testing.T) {
+	var gotDeeps []int
+	terminator := DelayedTerminator{
+		minimalDeep: 4,
+		terminator: MockNodeCounter{
+			countNode: func(deep int) { gotDeeps = append(gotDeeps, deep) },
+		},
+	}
+	terminator.CountNode(2)
+	terminator.CountNode(5)
+
+	if !reflect.DeepEqual(gotDeeps, []int{2, 5}) {
+		test.Fail()
+	}
+}
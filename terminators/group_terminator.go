@@ -1,5 +1,9 @@
 package terminators
 
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
 // GroupTerminator ...
 type GroupTerminator struct {
 	terminators []SearchTerminator
@@ -33,3 +37,40 @@ func (group GroupTerminator) SearchProgress(deep int) float64 {
 
 	return maximalProgress
 }
+
+// CountNode ...
+//
+// It forwards the call to terminators that implement
+// the NodeCounter interface.
+func (group GroupTerminator) CountNode(deep int) {
+	for _, terminator := range group.terminators {
+		CountNode(terminator, deep)
+	}
+}
+
+// IsIterationTerminated ...
+//
+// It forwards the call to terminators that implement
+// the IterationTerminator interface.
+func (group GroupTerminator) IsIterationTerminated(deep int) bool {
+	for _, terminator := range group.terminators {
+		if IsIterationTerminated(terminator, deep) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CompleteIteration ...
+//
+// It forwards the call to terminators that implement
+// the IterationTerminator interface.
+func (group GroupTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	for _, terminator := range group.terminators {
+		CompleteIteration(terminator, deep, move)
+	}
+}
@@ -3,6 +3,8 @@ package terminators
 import (
 	"reflect"
 	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
 )
 
 type MockSearchTerminator struct {
@@ -195,3 +197,116 @@ func TestGroupTerminatorSearchProgress(test *testing.T) {
 		}
 	}
 }
+
+func TestGroupTerminatorIsIterationTerminated(test *testing.T) {
+	type fields struct {
+		terminators []SearchTerminator
+	}
+	type args struct {
+		deep int
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{nil},
+			args:   args{2},
+			want:   false,
+		},
+		{
+			fields: fields{
+				terminators: []SearchTerminator{
+					MockSearchTerminator{},
+					MockIterationTerminator{
+						isIterationTerminated: func(deep int) bool {
+							if deep != 2 {
+								test.Fail()
+							}
+
+							return false
+						},
+					},
+				},
+			},
+			args: args{2},
+			want: false,
+		},
+		{
+			fields: fields{
+				terminators: []SearchTerminator{
+					MockSearchTerminator{},
+					MockIterationTerminator{
+						isIterationTerminated: func(deep int) bool {
+							if deep != 2 {
+								test.Fail()
+							}
+
+							return true
+						},
+					},
+				},
+			},
+			args: args{2},
+			want: true,
+		},
+	} {
+		group := GroupTerminator{
+			terminators: data.fields.terminators,
+		}
+		got := group.IsIterationTerminated(data.args.deep)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestGroupTerminatorCompleteIteration(test *testing.T) {
+	var gotDeeps []int
+	terminator := MockIterationTerminator{
+		completeIteration: func(deep int, move moves.FailedMove) {
+			if !reflect.DeepEqual(move.Move, moves.ScoredMove{Score: 2.3}) {
+				test.Fail()
+			}
+
+			gotDeeps = append(gotDeeps, deep)
+		},
+	}
+	group := GroupTerminator{
+		terminators: []SearchTerminator{
+			terminator,
+			MockSearchTerminator{},
+			terminator,
+		},
+	}
+	group.CompleteIteration(2, moves.FailedMove{
+		Move: moves.ScoredMove{Score: 2.3},
+	})
+
+	if !reflect.DeepEqual(gotDeeps, []int{2, 2}) {
+		test.Fail()
+	}
+}
+
+func TestGroupTerminatorCountNode(test *testing.T) {
+	var gotDeeps []int
+	terminator := MockNodeCounter{
+		countNode: func(deep int) { gotDeeps = append(gotDeeps, deep) },
+	}
+	group := GroupTerminator{
+		terminators: []SearchTerminator{
+			terminator,
+			MockSearchTerminator{},
+			terminator,
+		},
+	}
+	group.CountNode(2)
+
+	if !reflect.DeepEqual(gotDeeps, []int{2, 2}) {
+		test.Fail()
+	}
+}
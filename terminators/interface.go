@@ -1,5 +1,9 @@
 package terminators
 
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
 // SearchTerminator ...
 type SearchTerminator interface {
 	IsSearchTerminated(deep int) bool
@@ -7,3 +11,67 @@ type SearchTerminator interface {
 	// It should return a value between 0 and 1 inclusive.
 	SearchProgress(deep int) float64
 }
+
+// IterationTerminator ...
+//
+// It's an optional extension of the SearchTerminator interface,
+// which is supported by IterativeSearcher.
+type IterationTerminator interface {
+	SearchTerminator
+
+	// It's checked before starting of every iteration except a first one.
+	IsIterationTerminated(deep int) bool
+
+	// It's called with a result of every completed iteration.
+	CompleteIteration(deep int, move moves.FailedMove)
+}
+
+// NodeCounter ...
+//
+// It's an optional extension of the SearchTerminator interface,
+// which is supported by searchers visiting nodes of a tree,
+// e.g. AlphaBetaSearcher.
+type NodeCounter interface {
+	SearchTerminator
+
+	// It's called once for every visited node before checking
+	// of a termination.
+	CountNode(deep int)
+}
+
+// IsIterationTerminated ...
+//
+// It calls the same method of the terminator, if it implements
+// the IterationTerminator interface; otherwise, it returns false.
+func IsIterationTerminated(terminator SearchTerminator, deep int) bool {
+	iterationTerminator, ok := terminator.(IterationTerminator)
+	if !ok {
+		return false
+	}
+
+	return iterationTerminator.IsIterationTerminated(deep)
+}
+
+// CompleteIteration ...
+//
+// It calls the same method of the terminator, if it implements
+// the IterationTerminator interface; otherwise, it does nothing.
+func CompleteIteration(
+	terminator SearchTerminator,
+	deep int,
+	move moves.FailedMove,
+) {
+	if iterationTerminator, ok := terminator.(IterationTerminator); ok {
+		iterationTerminator.CompleteIteration(deep, move)
+	}
+}
+
+// CountNode ...
+//
+// It calls the same method of the terminator, if it implements
+// the NodeCounter interface; otherwise, it does nothing.
+func CountNode(terminator SearchTerminator, deep int) {
+	if nodeCounter, ok := terminator.(NodeCounter); ok {
+		nodeCounter.CountNode(deep)
+	}
+}
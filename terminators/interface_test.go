@@ -0,0 +1,128 @@
+package terminators
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+type MockIterationTerminator struct {
+	MockSearchTerminator
+
+	isIterationTerminated func(deep int) bool
+	completeIteration     func(deep int, move moves.FailedMove)
+}
+
+func (terminator MockIterationTerminator) IsIterationTerminated(
+	deep int,
+) bool {
+	if terminator.isIterationTerminated == nil {
+		panic("not implemented")
+	}
+
+	return terminator.isIterationTerminated(deep)
+}
+
+func (terminator MockIterationTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	if terminator.completeIteration == nil {
+		panic("not implemented")
+	}
+
+	terminator.completeIteration(deep, move)
+}
+
+type MockNodeCounter struct {
+	MockSearchTerminator
+
+	countNode func(deep int)
+}
+
+func (terminator MockNodeCounter) CountNode(deep int) {
+	if terminator.countNode == nil {
+		panic("not implemented")
+	}
+
+	terminator.countNode(deep)
+}
+
+func TestIsIterationTerminated(test *testing.T) {
+	type args struct {
+		terminator SearchTerminator
+		deep       int
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				terminator: MockSearchTerminator{},
+				deep:       2,
+			},
+			want: false,
+		},
+		{
+			args: args{
+				terminator: MockIterationTerminator{
+					isIterationTerminated: func(deep int) bool {
+						if deep != 2 {
+							test.Fail()
+						}
+
+						return true
+					},
+				},
+				deep: 2,
+			},
+			want: true,
+		},
+	} {
+		got := IsIterationTerminated(data.args.terminator, data.args.deep)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestCompleteIteration(test *testing.T) {
+	move := moves.FailedMove{Move: moves.ScoredMove{Score: 2.3}}
+
+	// it shouldn't panic
+	CompleteIteration(MockSearchTerminator{}, 2, move)
+
+	var gotDeep int
+	var gotMove moves.FailedMove
+	terminator := MockIterationTerminator{
+		completeIteration: func(deep int, move moves.FailedMove) {
+			gotDeep = deep
+			gotMove = move
+		},
+	}
+	CompleteIteration(terminator, 2, move)
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(gotMove, move) {
+		test.Fail()
+	}
+}
+
+func TestCountNode(test *testing.T) {
+	// it should do nothing
+	CountNode(MockSearchTerminator{}, 2)
+
+	var gotDeep int
+	CountNode(MockNodeCounter{countNode: func(deep int) { gotDeep = deep }}, 2)
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
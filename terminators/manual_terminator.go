@@ -29,3 +29,11 @@ func (terminator *ManualTerminator) Terminate() {
 	flag := &terminator.terminationFlag
 	atomic.StoreUint64(flag, 1)
 }
+
+// Reset ...
+//
+// It allows reusing the terminator for another search.
+func (terminator *ManualTerminator) Reset() {
+	flag := &terminator.terminationFlag
+	atomic.StoreUint64(flag, 0)
+}
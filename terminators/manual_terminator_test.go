@@ -85,3 +85,13 @@ func TestManualTerminatorTerminate(test *testing.T) {
 		test.Fail()
 	}
 }
+
+func TestManualTerminatorReset(test *testing.T) {
+	terminator := ManualTerminator{terminationFlag: 1}
+	terminator.Reset()
+
+	flag := terminator.terminationFlag
+	if flag != 0 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,52 @@
+package terminators
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// NegatedTerminator ...
+//
+// It terminates a search, when its inner terminator doesn't, and vice versa.
+// Its progress is a complement of a progress of the inner terminator.
+//
+// It supports the IterationTerminator interface: it terminates an iteration,
+// when the inner terminator terminates neither the iteration nor a whole
+// search. It forwards visited nodes to the inner terminator, if it supports
+// the NodeCounter interface.
+type NegatedTerminator struct {
+	terminator SearchTerminator
+}
+
+// NewNegatedTerminator ...
+func NewNegatedTerminator(terminator SearchTerminator) NegatedTerminator {
+	return NegatedTerminator{terminator}
+}
+
+// IsSearchTerminated ...
+func (terminator NegatedTerminator) IsSearchTerminated(deep int) bool {
+	return !terminator.terminator.IsSearchTerminated(deep)
+}
+
+// SearchProgress ...
+func (terminator NegatedTerminator) SearchProgress(deep int) float64 {
+	return 1 - terminator.terminator.SearchProgress(deep)
+}
+
+// CountNode ...
+func (terminator NegatedTerminator) CountNode(deep int) {
+	CountNode(terminator.terminator, deep)
+}
+
+// IsIterationTerminated ...
+func (terminator NegatedTerminator) IsIterationTerminated(deep int) bool {
+	return !IsIterationTerminated(terminator.terminator, deep) &&
+		!terminator.terminator.IsSearchTerminated(deep)
+}
+
+// CompleteIteration ...
+func (terminator NegatedTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	CompleteIteration(terminator.terminator, deep, move)
+}
@@ -0,0 +1,139 @@
+package terminators
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+func TestNewNegatedTerminator(test *testing.T) {
+	var innerTerminator MockSearchTerminator
+	terminator := NewNegatedTerminator(innerTerminator)
+
+	if !reflect.DeepEqual(terminator.terminator, innerTerminator) {
+		test.Fail()
+	}
+}
+
+func TestNegatedTerminator(test *testing.T) {
+	for _, result := range []bool{false, true} {
+		result := result
+		terminator := NegatedTerminator{
+			terminator: MockSearchTerminator{
+				isSearchTerminated: func(deep int) bool {
+					if deep != 2 {
+						test.Fail()
+					}
+
+					return result
+				},
+				searchProgress: func(deep int) float64 {
+					if deep != 2 {
+						test.Fail()
+					}
+
+					return 0.25
+				},
+			},
+		}
+
+		if terminator.IsSearchTerminated(2) == result {
+			test.Fail()
+		}
+		if terminator.SearchProgress(2) != 0.75 {
+			test.Fail()
+		}
+	}
+}
+
+func TestNegatedTerminatorCountNode(test *testing.T) {
+	var gotDeep int
+	terminator := NegatedTerminator{
+		terminator: MockNodeCounter{
+			countNode: func(deep int) { gotDeep = deep },
+		},
+	}
+	terminator.CountNode(2)
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
+
+func TestNegatedTerminatorIsIterationTerminated(test *testing.T) {
+	type args struct {
+		terminator SearchTerminator
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool { return false },
+				},
+			},
+			want: true,
+		},
+		{
+			args: args{
+				terminator: MockSearchTerminator{
+					isSearchTerminated: func(deep int) bool { return true },
+				},
+			},
+			want: false,
+		},
+		{
+			args: args{
+				terminator: MockIterationTerminator{
+					isIterationTerminated: func(deep int) bool {
+						if deep != 2 {
+							test.Fail()
+						}
+
+						return true
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			args: args{
+				terminator: MockIterationTerminator{
+					MockSearchTerminator: MockSearchTerminator{
+						isSearchTerminated: func(deep int) bool { return false },
+					},
+					isIterationTerminated: func(deep int) bool { return false },
+				},
+			},
+			want: true,
+		},
+	} {
+		terminator := NegatedTerminator{terminator: data.args.terminator}
+		got := terminator.IsIterationTerminated(2)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestNegatedTerminatorCompleteIteration(test *testing.T) {
+	var gotDeep int
+	terminator := NegatedTerminator{
+		terminator: MockIterationTerminator{
+			completeIteration: func(deep int, move moves.FailedMove) {
+				gotDeep = deep
+			},
+		},
+	}
+	terminator.CompleteIteration(2, moves.FailedMove{})
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
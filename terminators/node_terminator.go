@@ -0,0 +1,69 @@
+package terminators
+
+import (
+	"sync/atomic"
+)
+
+// NodeTerminator ...
+//
+// It terminates a search after the specified number of visited nodes,
+// so its results don't depend on a hardware. Nodes are reported by searchers
+// via the NodeCounter interface, so other checks of a termination
+// (e.g. between iterations of IterativeSearcher) don't affect a count.
+//
+// A count is atomic, so workers of ParallelSearcher can share the terminator
+// to limit a total number of their nodes.
+type NodeTerminator struct {
+	visitedNodes uint64
+	maximalNodes uint64
+}
+
+// NewNodeTerminator ...
+//
+// The budget is inclusive: searchers count a node before checking
+// of a termination, so a search visits at most the specified number of nodes
+// and a last of them is already terminated.
+//
+// It panics, if the number is negative, because otherwise it would wrap around
+// to an almost unlimited budget.
+func NewNodeTerminator(maximalNodes int) *NodeTerminator {
+	if maximalNodes < 0 {
+		panic("negative maximal nodes")
+	}
+
+	return &NodeTerminator{maximalNodes: uint64(maximalNodes)}
+}
+
+// IsSearchTerminated ...
+func (terminator *NodeTerminator) IsSearchTerminated(deep int) bool {
+	visitedNodes := atomic.LoadUint64(&terminator.visitedNodes)
+	return visitedNodes >= terminator.maximalNodes
+}
+
+// SearchProgress ...
+func (terminator *NodeTerminator) SearchProgress(deep int) float64 {
+	// it's consistent with IsSearchTerminated()
+	visitedNodes := terminator.VisitedNodes()
+	if visitedNodes >= int(terminator.maximalNodes) {
+		return 1
+	}
+
+	return float64(visitedNodes) / float64(terminator.maximalNodes)
+}
+
+// CountNode ...
+func (terminator *NodeTerminator) CountNode(deep int) {
+	atomic.AddUint64(&terminator.visitedNodes, 1)
+}
+
+// VisitedNodes ...
+func (terminator *NodeTerminator) VisitedNodes() int {
+	return int(atomic.LoadUint64(&terminator.visitedNodes))
+}
+
+// Reset ...
+//
+// It allows reusing the terminator for another search.
+func (terminator *NodeTerminator) Reset() {
+	atomic.StoreUint64(&terminator.visitedNodes, 0)
+}
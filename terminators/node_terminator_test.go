@@ -0,0 +1,191 @@
+package terminators
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNewNodeTerminator(test *testing.T) {
+	terminator := NewNodeTerminator(5)
+
+	if terminator.visitedNodes != 0 {
+		test.Fail()
+	}
+	if terminator.maximalNodes != 5 {
+		test.Fail()
+	}
+}
+
+func TestNewNodeTerminator_withNegativeNodes(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		NewNodeTerminator(-1)
+	}()
+
+	if err != "negative maximal nodes" {
+		test.Fail()
+	}
+}
+
+func TestNodeTerminatorIsSearchTerminated(test *testing.T) {
+	type fields struct {
+		visitedNodes uint64
+		maximalNodes uint64
+	}
+	type args struct {
+		deep int
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{4, 5},
+			args:   args{2},
+			want:   false,
+		},
+		{
+			fields: fields{5, 5},
+			args:   args{2},
+			want:   true,
+		},
+		{
+			fields: fields{6, 5},
+			args:   args{2},
+			want:   true,
+		},
+		{
+			fields: fields{0, 0},
+			args:   args{2},
+			want:   true,
+		},
+	} {
+		terminator := NodeTerminator{
+			visitedNodes: data.fields.visitedNodes,
+			maximalNodes: data.fields.maximalNodes,
+		}
+		got := terminator.IsSearchTerminated(data.args.deep)
+
+		if got != data.want {
+			test.Fail()
+		}
+		// it shouldn't count nodes
+		if terminator.visitedNodes != data.fields.visitedNodes {
+			test.Fail()
+		}
+	}
+}
+
+func TestNodeTerminatorCountNode(test *testing.T) {
+	terminator := NodeTerminator{visitedNodes: 23}
+	terminator.CountNode(2)
+
+	if terminator.visitedNodes != 24 {
+		test.Fail()
+	}
+}
+
+func TestNodeTerminatorCountNode_concurrently(test *testing.T) {
+	terminator := NewNodeTerminator(1000)
+
+	var waiter sync.WaitGroup
+	var lock sync.Mutex
+	var notTerminatedCount int
+	for i := 0; i < 10; i++ {
+		waiter.Add(1)
+		go func() {
+			defer waiter.Done()
+
+			for j := 0; j < 200; j++ {
+				terminator.CountNode(j)
+				if !terminator.IsSearchTerminated(j) {
+					lock.Lock()
+					notTerminatedCount++
+					lock.Unlock()
+				}
+			}
+		}()
+	}
+	waiter.Wait()
+
+	// a node can be counted by another goroutine between calls
+	if notTerminatedCount >= 1000 {
+		test.Fail()
+	}
+	if terminator.VisitedNodes() != 2000 {
+		test.Fail()
+	}
+}
+
+func TestNodeTerminatorSearchProgress(test *testing.T) {
+	type fields struct {
+		visitedNodes uint64
+		maximalNodes uint64
+	}
+	type args struct {
+		deep int
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   float64
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{1, 4},
+			args:   args{2},
+			want:   0.25,
+		},
+		{
+			fields: fields{4, 4},
+			args:   args{2},
+			want:   1,
+		},
+		{
+			fields: fields{5, 4},
+			args:   args{2},
+			want:   1,
+		},
+	} {
+		terminator := NodeTerminator{
+			visitedNodes: data.fields.visitedNodes,
+			maximalNodes: data.fields.maximalNodes,
+		}
+		got := terminator.SearchProgress(data.args.deep)
+
+		if got != data.want {
+			test.Fail()
+		}
+		// it shouldn't count nodes
+		if terminator.visitedNodes != data.fields.visitedNodes {
+			test.Fail()
+		}
+	}
+}
+
+func TestNodeTerminatorVisitedNodes(test *testing.T) {
+	terminator := NodeTerminator{visitedNodes: 23}
+	got := terminator.VisitedNodes()
+
+	if got != 23 {
+		test.Fail()
+	}
+}
+
+func TestNodeTerminatorReset(test *testing.T) {
+	terminator := NodeTerminator{visitedNodes: 23, maximalNodes: 5}
+	terminator.Reset()
+
+	if terminator.visitedNodes != 0 {
+		test.Fail()
+	}
+	if terminator.IsSearchTerminated(2) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,136 @@
+package terminators
+
+import (
+	"sync"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+const (
+	pausePollingPeriod = 10 * time.Millisecond
+)
+
+// Pauser ...
+//
+// It's safe for concurrent use.
+type Pauser struct {
+	lock sync.Mutex
+	// it's nil, when a search isn't paused
+	resumption chan struct{}
+}
+
+// Pause ...
+func (pauser *Pauser) Pause() {
+	pauser.lock.Lock()
+	defer pauser.lock.Unlock()
+
+	if pauser.resumption == nil {
+		pauser.resumption = make(chan struct{})
+	}
+}
+
+// Resume ...
+func (pauser *Pauser) Resume() {
+	pauser.lock.Lock()
+	defer pauser.lock.Unlock()
+
+	if pauser.resumption != nil {
+		close(pauser.resumption)
+		pauser.resumption = nil
+	}
+}
+
+// IsPaused ...
+func (pauser *Pauser) IsPaused() bool {
+	return pauser.currentResumption() != nil
+}
+
+func (pauser *Pauser) currentResumption() <-chan struct{} {
+	pauser.lock.Lock()
+	defer pauser.lock.Unlock()
+
+	return pauser.resumption
+}
+
+// PausableTerminator ...
+//
+// While its pauser is paused, it blocks searchers in IsSearchTerminated(),
+// so they keep their state and continue after resuming. Meanwhile,
+// it polls its inner terminator, so a paused search still can be terminated.
+// Time-based terminators keep counting while a search is paused.
+//
+// It supports the IterationTerminator and NodeCounter interfaces,
+// if its inner terminator does. Polling of the inner terminator doesn't count
+// visited nodes.
+type PausableTerminator struct {
+	pauser     *Pauser
+	terminator SearchTerminator
+}
+
+// NewPausableTerminator ...
+func NewPausableTerminator(
+	pauser *Pauser,
+	terminator SearchTerminator,
+) PausableTerminator {
+	return PausableTerminator{
+		pauser:     pauser,
+		terminator: terminator,
+	}
+}
+
+// IsSearchTerminated ...
+func (terminator PausableTerminator) IsSearchTerminated(deep int) bool {
+	if ok := terminator.waitResumption(deep); !ok {
+		return true
+	}
+
+	return terminator.terminator.IsSearchTerminated(deep)
+}
+
+// SearchProgress ...
+func (terminator PausableTerminator) SearchProgress(deep int) float64 {
+	return terminator.terminator.SearchProgress(deep)
+}
+
+// CountNode ...
+func (terminator PausableTerminator) CountNode(deep int) {
+	CountNode(terminator.terminator, deep)
+}
+
+// IsIterationTerminated ...
+func (terminator PausableTerminator) IsIterationTerminated(deep int) bool {
+	if ok := terminator.waitResumption(deep); !ok {
+		return true
+	}
+
+	return IsIterationTerminated(terminator.terminator, deep)
+}
+
+// CompleteIteration ...
+func (terminator PausableTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	CompleteIteration(terminator.terminator, deep, move)
+}
+
+// it returns false, if a search was terminated while waiting
+func (terminator PausableTerminator) waitResumption(deep int) bool {
+	for {
+		resumption := terminator.pauser.currentResumption()
+		if resumption == nil {
+			return true
+		}
+		if terminator.terminator.IsSearchTerminated(deep) {
+			return false
+		}
+
+		timer := time.NewTimer(pausePollingPeriod)
+		select {
+		case <-resumption:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
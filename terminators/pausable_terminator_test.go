@@ -0,0 +1,182 @@
+package terminators
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+func TestPauser(test *testing.T) {
+	var pauser Pauser
+	if pauser.IsPaused() {
+		test.Fail()
+	}
+
+	pauser.Pause()
+	pauser.Pause() // it should be idempotent
+	if !pauser.IsPaused() {
+		test.Fail()
+	}
+
+	resumption := pauser.currentResumption()
+	pauser.Resume()
+	pauser.Resume() // it should be idempotent
+	if pauser.IsPaused() {
+		test.Fail()
+	}
+
+	select {
+	case <-resumption:
+	default:
+		test.Fail()
+	}
+}
+
+func TestNewPausableTerminator(test *testing.T) {
+	pauser := new(Pauser)
+	var innerTerminator MockSearchTerminator
+	terminator := NewPausableTerminator(pauser, innerTerminator)
+
+	if terminator.pauser != pauser {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(terminator.terminator, innerTerminator) {
+		test.Fail()
+	}
+}
+
+func TestPausableTerminatorIsSearchTerminated(test *testing.T) {
+	var isTerminated uint64
+	pauser := new(Pauser)
+	terminator := PausableTerminator{
+		pauser: pauser,
+		terminator: MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool {
+				return atomic.LoadUint64(&isTerminated) != 0
+			},
+		},
+	}
+
+	// not paused
+	if terminator.IsSearchTerminated(2) {
+		test.Fail()
+	}
+
+	// paused and resumed
+	pauser.Pause()
+	result := make(chan bool)
+	go func() { result <- terminator.IsSearchTerminated(2) }()
+
+	select {
+	case <-result:
+		test.Fail() // it should block while paused
+	case <-time.After(5 * pausePollingPeriod):
+	}
+
+	pauser.Resume()
+	if <-result {
+		test.Fail()
+	}
+
+	// paused and terminated
+	pauser.Pause()
+	go func() { result <- terminator.IsSearchTerminated(2) }()
+
+	atomic.StoreUint64(&isTerminated, 1)
+	if !<-result {
+		test.Fail()
+	}
+}
+
+func TestPausableTerminatorSearchProgress(test *testing.T) {
+	pauser := new(Pauser)
+	pauser.Pause()
+
+	terminator := PausableTerminator{
+		pauser: pauser,
+		terminator: MockSearchTerminator{
+			searchProgress: func(deep int) float64 {
+				if deep != 2 {
+					test.Fail()
+				}
+
+				return 0.25
+			},
+		},
+	}
+
+	// it shouldn't block
+	if terminator.SearchProgress(2) != 0.25 {
+		test.Fail()
+	}
+}
+
+func TestPausableTerminatorIsIterationTerminated(test *testing.T) {
+	pauser := new(Pauser)
+	terminator := PausableTerminator{
+		pauser: pauser,
+		terminator: MockIterationTerminator{
+			MockSearchTerminator: MockSearchTerminator{
+				isSearchTerminated: func(deep int) bool { return false },
+			},
+			isIterationTerminated: func(deep int) bool { return deep > 2 },
+		},
+	}
+
+	if terminator.IsIterationTerminated(2) {
+		test.Fail()
+	}
+	if !terminator.IsIterationTerminated(3) {
+		test.Fail()
+	}
+
+	pauser.Pause()
+	result := make(chan bool)
+	go func() { result <- terminator.IsIterationTerminated(2) }()
+
+	select {
+	case <-result:
+		test.Fail() // it should block while paused
+	case <-time.After(5 * pausePollingPeriod):
+	}
+
+	pauser.Resume()
+	if <-result {
+		test.Fail()
+	}
+}
+
+func TestPausableTerminatorCompleteIteration(test *testing.T) {
+	var gotDeep int
+	terminator := PausableTerminator{
+		pauser: new(Pauser),
+		terminator: MockIterationTerminator{
+			completeIteration: func(deep int, move moves.FailedMove) {
+				gotDeep = deep
+			},
+		},
+	}
+	terminator.CompleteIteration(2, moves.FailedMove{})
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
+
+func TestPausableTerminatorCountNode(test *testing.T) {
+	var gotDeep int
+	terminator := PausableTerminator{
+		pauser: new(Pauser),
+		terminator: MockNodeCounter{
+			countNode: func(deep int) { gotDeep = deep },
+		},
+	}
+	terminator.CountNode(2)
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
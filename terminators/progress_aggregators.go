@@ -0,0 +1,70 @@
+package terminators
+
+import (
+	"sort"
+)
+
+// ProgressAggregator ...
+//
+// It combines progresses of several terminators into a single one.
+// It receives at least one progress.
+type ProgressAggregator func(progresses []float64) float64
+
+// MaximalProgress ...
+func MaximalProgress(progresses []float64) float64 {
+	return sortedProgresses(progresses)[len(progresses)-1]
+}
+
+// MinimalProgress ...
+func MinimalProgress(progresses []float64) float64 {
+	return sortedProgresses(progresses)[0]
+}
+
+// WeightedProgress ...
+//
+// It returns an aggregator that computes a weighted average of progresses.
+// Weights correspond to progresses by their order; missed ones are
+// considered equal to one.
+func WeightedProgress(weights ...float64) ProgressAggregator {
+	return func(progresses []float64) float64 {
+		var sum, weightSum float64
+		for index, progress := range progresses {
+			weight := 1.0
+			if index < len(weights) {
+				weight = weights[index]
+			}
+
+			sum += weight * progress
+			weightSum += weight
+		}
+		if weightSum == 0 {
+			return 0
+		}
+
+		return sum / weightSum
+	}
+}
+
+// it returns a progress of the specified order from the largest one,
+// i.e. a progress, when the specified number of terminators have fired
+func quorumProgress(quorum int) ProgressAggregator {
+	return func(progresses []float64) float64 {
+		index := len(progresses) - quorum
+		if index < 0 {
+			index = 0
+		}
+		if index >= len(progresses) {
+			index = len(progresses) - 1
+		}
+
+		return sortedProgresses(progresses)[index]
+	}
+}
+
+func sortedProgresses(progresses []float64) []float64 {
+	sorted := make([]float64, len(progresses))
+	copy(sorted, progresses)
+	sort.Float64s(sorted)
+
+	return sorted
+}
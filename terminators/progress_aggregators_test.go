@@ -0,0 +1,108 @@
+package terminators
+
+import (
+	"testing"
+)
+
+func TestMaximalProgress(test *testing.T) {
+	progresses := []float64{0.5, 0.75, 0.25}
+	got := MaximalProgress(progresses)
+
+	if got != 0.75 {
+		test.Fail()
+	}
+	// it shouldn't change progresses
+	if progresses[0] != 0.5 {
+		test.Fail()
+	}
+}
+
+func TestMinimalProgress(test *testing.T) {
+	got := MinimalProgress([]float64{0.5, 0.75, 0.25})
+
+	if got != 0.25 {
+		test.Fail()
+	}
+}
+
+func TestWeightedProgress(test *testing.T) {
+	type args struct {
+		weights    []float64
+		progresses []float64
+	}
+	type data struct {
+		args args
+		want float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				weights:    []float64{3, 1},
+				progresses: []float64{0.5, 1},
+			},
+			want: 0.625,
+		},
+		// missed weights are equal to one
+		{
+			args: args{
+				weights:    nil,
+				progresses: []float64{0.5, 1},
+			},
+			want: 0.75,
+		},
+		{
+			args: args{
+				weights:    []float64{0, 0},
+				progresses: []float64{0.5, 1},
+			},
+			want: 0,
+		},
+	} {
+		got := WeightedProgress(data.args.weights...)(data.args.progresses)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestQuorumProgress(test *testing.T) {
+	type args struct {
+		quorum     int
+		progresses []float64
+	}
+	type data struct {
+		args args
+		want float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{1, []float64{0.5, 0.75, 0.25}},
+			want: 0.75,
+		},
+		{
+			args: args{2, []float64{0.5, 0.75, 0.25}},
+			want: 0.5,
+		},
+		{
+			args: args{3, []float64{0.5, 0.75, 0.25}},
+			want: 0.25,
+		},
+		{
+			args: args{5, []float64{0.5, 0.75, 0.25}},
+			want: 0.25,
+		},
+		{
+			args: args{0, []float64{0.5, 0.75, 0.25}},
+			want: 0.75,
+		},
+	} {
+		got := quorumProgress(data.args.quorum)(data.args.progresses)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package terminators
+
+import (
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// QuorumTerminator ...
+//
+// It terminates a search, when at least the specified number of its
+// terminators have fired. A group without terminators never fires.
+//
+// It supports the IterationTerminator interface: a terminator is considered
+// fired for an iteration, if it terminates either the iteration
+// or a whole search.
+type QuorumTerminator struct {
+	quorum      int
+	terminators []SearchTerminator
+	aggregator  ProgressAggregator
+}
+
+// NewQuorumTerminator ...
+//
+// A nil aggregator means a progress, on reaching of which by the specified
+// number of terminators the group fires.
+//
+// It panics, if the quorum isn't positive, because otherwise the group
+// would fire without any fired terminator.
+func NewQuorumTerminator(
+	quorum int,
+	aggregator ProgressAggregator,
+	terminators ...SearchTerminator,
+) QuorumTerminator {
+	if quorum <= 0 {
+		panic("non-positive quorum")
+	}
+
+	if aggregator == nil {
+		aggregator = quorumProgress(quorum)
+	}
+
+	return QuorumTerminator{
+		quorum:      quorum,
+		terminators: terminators,
+		aggregator:  aggregator,
+	}
+}
+
+// NewConjunctiveTerminator ...
+//
+// It makes a group that fires, when all its terminators have fired.
+// A nil aggregator means MinimalProgress().
+func NewConjunctiveTerminator(
+	aggregator ProgressAggregator,
+	terminators ...SearchTerminator,
+) QuorumTerminator {
+	if aggregator == nil {
+		aggregator = MinimalProgress
+	}
+
+	quorum := len(terminators)
+	if quorum == 0 {
+		// a group without terminators never fires regardless of a quorum
+		quorum = 1
+	}
+
+	return NewQuorumTerminator(quorum, aggregator, terminators...)
+}
+
+// IsSearchTerminated ...
+func (group QuorumTerminator) IsSearchTerminated(deep int) bool {
+	return group.hasQuorum(func(terminator SearchTerminator) bool {
+		return terminator.IsSearchTerminated(deep)
+	})
+}
+
+// SearchProgress ...
+func (group QuorumTerminator) SearchProgress(deep int) float64 {
+	if len(group.terminators) == 0 {
+		return 0
+	}
+
+	var progresses []float64
+	for _, terminator := range group.terminators {
+		progresses = append(progresses, terminator.SearchProgress(deep))
+	}
+
+	return group.aggregator(progresses)
+}
+
+// CountNode ...
+//
+// It forwards the call to terminators that implement
+// the NodeCounter interface.
+func (group QuorumTerminator) CountNode(deep int) {
+	for _, terminator := range group.terminators {
+		CountNode(terminator, deep)
+	}
+}
+
+// IsIterationTerminated ...
+func (group QuorumTerminator) IsIterationTerminated(deep int) bool {
+	return group.hasQuorum(func(terminator SearchTerminator) bool {
+		return IsIterationTerminated(terminator, deep) ||
+			terminator.IsSearchTerminated(deep)
+	})
+}
+
+// CompleteIteration ...
+func (group QuorumTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	for _, terminator := range group.terminators {
+		CompleteIteration(terminator, deep, move)
+	}
+}
+
+func (group QuorumTerminator) hasQuorum(
+	isFired func(terminator SearchTerminator) bool,
+) bool {
+	if len(group.terminators) == 0 {
+		return false
+	}
+
+	var firedCount int
+	for _, terminator := range group.terminators {
+		if isFired(terminator) {
+			firedCount++
+			if firedCount >= group.quorum {
+				return true
+			}
+		}
+	}
+
+	return false
+}
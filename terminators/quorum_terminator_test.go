@@ -0,0 +1,278 @@
+package terminators
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+func TestNewQuorumTerminator(test *testing.T) {
+	terminators := []SearchTerminator{
+		MockSearchTerminator{},
+		MockSearchTerminator{},
+	}
+	group := NewQuorumTerminator(2, MaximalProgress, terminators...)
+
+	if group.quorum != 2 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(group.terminators, terminators) {
+		test.Fail()
+	}
+
+	gotAggregator := reflect.ValueOf(group.aggregator).Pointer()
+	wantAggregator := reflect.ValueOf(MaximalProgress).Pointer()
+	if gotAggregator != wantAggregator {
+		test.Fail()
+	}
+}
+
+func TestNewQuorumTerminator_withDefaultAggregator(test *testing.T) {
+	group := NewQuorumTerminator(2, nil)
+
+	if group.aggregator([]float64{0.5, 0.75, 0.25}) != 0.5 {
+		test.Fail()
+	}
+}
+
+func TestNewQuorumTerminator_withNonPositiveQuorum(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		NewQuorumTerminator(0, nil, MockSearchTerminator{})
+	}()
+
+	if err != "non-positive quorum" {
+		test.Fail()
+	}
+}
+
+func TestNewConjunctiveTerminator(test *testing.T) {
+	terminators := []SearchTerminator{
+		MockSearchTerminator{},
+		MockSearchTerminator{},
+		MockSearchTerminator{},
+	}
+	group := NewConjunctiveTerminator(nil, terminators...)
+
+	if group.quorum != 3 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(group.terminators, terminators) {
+		test.Fail()
+	}
+
+	gotAggregator := reflect.ValueOf(group.aggregator).Pointer()
+	wantAggregator := reflect.ValueOf(MinimalProgress).Pointer()
+	if gotAggregator != wantAggregator {
+		test.Fail()
+	}
+}
+
+func TestNewConjunctiveTerminator_withoutTerminators(test *testing.T) {
+	group := NewConjunctiveTerminator(nil)
+
+	if group.quorum != 1 {
+		test.Fail()
+	}
+	if group.IsSearchTerminated(2) {
+		test.Fail()
+	}
+}
+
+func TestQuorumTerminatorIsSearchTerminated(test *testing.T) {
+	type fields struct {
+		quorum  int
+		results []bool
+	}
+	type data struct {
+		fields fields
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{1, nil},
+			want:   false,
+		},
+		{
+			fields: fields{2, []bool{true, false, false}},
+			want:   false,
+		},
+		{
+			fields: fields{2, []bool{true, false, true}},
+			want:   true,
+		},
+		{
+			fields: fields{3, []bool{true, true, false}},
+			want:   false,
+		},
+		{
+			fields: fields{3, []bool{true, true, true}},
+			want:   true,
+		},
+	} {
+		var terminators []SearchTerminator
+		for _, result := range data.fields.results {
+			result := result
+			terminators = append(terminators, MockSearchTerminator{
+				isSearchTerminated: func(deep int) bool {
+					if deep != 2 {
+						test.Fail()
+					}
+
+					return result
+				},
+			})
+		}
+
+		group := QuorumTerminator{
+			quorum:      data.fields.quorum,
+			terminators: terminators,
+		}
+		got := group.IsSearchTerminated(2)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestQuorumTerminatorSearchProgress(test *testing.T) {
+	group := QuorumTerminator{
+		terminators: []SearchTerminator{
+			MockSearchTerminator{
+				searchProgress: func(deep int) float64 {
+					if deep != 2 {
+						test.Fail()
+					}
+
+					return 0.5
+				},
+			},
+			MockSearchTerminator{
+				searchProgress: func(deep int) float64 {
+					if deep != 2 {
+						test.Fail()
+					}
+
+					return 1
+				},
+			},
+		},
+		aggregator: WeightedProgress(3, 1),
+	}
+	got := group.SearchProgress(2)
+
+	if got != 0.625 {
+		test.Fail()
+	}
+
+	// a group without terminators
+	if (QuorumTerminator{}).SearchProgress(2) != 0 {
+		test.Fail()
+	}
+}
+
+func TestQuorumTerminatorIsIterationTerminated(test *testing.T) {
+	type fields struct {
+		quorum      int
+		terminators []SearchTerminator
+	}
+	type data struct {
+		fields fields
+		want   bool
+	}
+
+	searchTerminator := func(result bool) SearchTerminator {
+		return MockSearchTerminator{
+			isSearchTerminated: func(deep int) bool { return result },
+		}
+	}
+	iterationTerminator := func(result bool) SearchTerminator {
+		return MockIterationTerminator{
+			MockSearchTerminator: MockSearchTerminator{
+				isSearchTerminated: func(deep int) bool { return false },
+			},
+			isIterationTerminated: func(deep int) bool { return result },
+		}
+	}
+	for _, data := range []data{
+		{
+			fields: fields{1, nil},
+			want:   false,
+		},
+		{
+			fields: fields{
+				quorum: 2,
+				terminators: []SearchTerminator{
+					searchTerminator(true),
+					iterationTerminator(false),
+				},
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				quorum: 2,
+				terminators: []SearchTerminator{
+					searchTerminator(true),
+					iterationTerminator(true),
+				},
+			},
+			want: true,
+		},
+	} {
+		group := QuorumTerminator{
+			quorum:      data.fields.quorum,
+			terminators: data.fields.terminators,
+		}
+		got := group.IsIterationTerminated(2)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestQuorumTerminatorCompleteIteration(test *testing.T) {
+	var gotDeeps []int
+	terminator := MockIterationTerminator{
+		completeIteration: func(deep int, move moves.FailedMove) {
+			gotDeeps = append(gotDeeps, deep)
+		},
+	}
+	group := QuorumTerminator{
+		terminators: []SearchTerminator{
+			terminator,
+			MockSearchTerminator{},
+			terminator,
+		},
+	}
+	group.CompleteIteration(2, moves.FailedMove{})
+
+	if !reflect.DeepEqual(gotDeeps, []int{2, 2}) {
+		test.Fail()
+	}
+}
+
+func TestQuorumTerminatorCountNode(test *testing.T) {
+	var gotDeeps []int
+	terminator := MockNodeCounter{
+		countNode: func(deep int) { gotDeeps = append(gotDeeps, deep) },
+	}
+	group := QuorumTerminator{
+		terminators: []SearchTerminator{
+			terminator,
+			MockSearchTerminator{},
+			terminator,
+		},
+	}
+	group.CountNode(2)
+
+	if !reflect.DeepEqual(gotDeeps, []int{2, 2}) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,78 @@
+package terminators
+
+import (
+	"sync"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// StabilityTerminator ...
+//
+// It stops iterative deepening, when a best move and its score have been
+// the same for the specified number of iterations in a row, when a forced
+// checkmate has been found, or when a root has no legal moves. It never
+// aborts a running iteration, so it should be grouped with other terminators.
+//
+// Its state is guarded by a lock, because in ParallelSearcher iterations
+// are completed by a main worker, while helpers check them concurrently.
+type StabilityTerminator struct {
+	stableIterations int
+
+	lock              sync.RWMutex
+	lastMove          moves.FailedMove
+	currentIterations int
+	isResolved        bool
+}
+
+// NewStabilityTerminator ...
+func NewStabilityTerminator(stableIterations int) *StabilityTerminator {
+	return &StabilityTerminator{stableIterations: stableIterations}
+}
+
+// IsSearchTerminated ...
+//
+// It always returns false.
+func (terminator *StabilityTerminator) IsSearchTerminated(deep int) bool {
+	return false
+}
+
+// SearchProgress ...
+//
+// It always returns zero, because stability doesn't relate to a progress
+// of a search.
+func (terminator *StabilityTerminator) SearchProgress(deep int) float64 {
+	return 0
+}
+
+// IsIterationTerminated ...
+func (terminator *StabilityTerminator) IsIterationTerminated(deep int) bool {
+	terminator.lock.RLock()
+	defer terminator.lock.RUnlock()
+
+	return terminator.isResolved ||
+		terminator.currentIterations >= terminator.stableIterations
+}
+
+// CompleteIteration ...
+func (terminator *StabilityTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	terminator.lock.Lock()
+	defer terminator.lock.Unlock()
+
+	// a checkmate or a draw on a root
+	if move.Error != nil || moves.IsMateScore(move.Move.Score) {
+		terminator.isResolved = true
+	}
+
+	if terminator.currentIterations != 0 &&
+		move.Move.Move == terminator.lastMove.Move.Move &&
+		move.Move.Score == terminator.lastMove.Move.Score {
+		terminator.currentIterations++
+	} else {
+		terminator.currentIterations = 1
+	}
+
+	terminator.lastMove = move
+}
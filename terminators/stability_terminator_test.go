@@ -0,0 +1,113 @@
+package terminators
+
+import (
+	"errors"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewStabilityTerminator(test *testing.T) {
+	terminator := NewStabilityTerminator(3)
+
+	if terminator.stableIterations != 3 {
+		test.Fail()
+	}
+	if terminator.IsIterationTerminated(1) {
+		test.Fail()
+	}
+}
+
+func TestStabilityTerminatorIsSearchTerminated(test *testing.T) {
+	terminator := NewStabilityTerminator(3)
+	terminator.CompleteIteration(1, moves.FailedMove{Error: errors.New("dummy")})
+
+	if terminator.IsSearchTerminated(2) {
+		test.Fail()
+	}
+	if terminator.SearchProgress(2) != 0 {
+		test.Fail()
+	}
+}
+
+func TestStabilityTerminatorCompleteIteration(test *testing.T) {
+	type data struct {
+		iterations []moves.FailedMove
+		want       bool
+	}
+
+	moveOne := models.Move{
+		Start:  models.Position{File: 1, Rank: 2},
+		Finish: models.Position{File: 3, Rank: 4},
+	}
+	moveTwo := models.Move{
+		Start:  models.Position{File: 5, Rank: 6},
+		Finish: models.Position{File: 7, Rank: 8},
+	}
+	for _, data := range []data{
+		{
+			iterations: []moves.FailedMove{
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+			},
+			want: false,
+		},
+		{
+			iterations: []moves.FailedMove{
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+			},
+			want: true,
+		},
+		// a changed move resets stability
+		{
+			iterations: []moves.FailedMove{
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveTwo, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveTwo, Score: 2.3}},
+			},
+			want: false,
+		},
+		// a changed score resets stability
+		{
+			iterations: []moves.FailedMove{
+				{Move: moves.ScoredMove{Move: moveOne, Score: 2.3}},
+				{Move: moves.ScoredMove{Move: moveOne, Score: 4.2}},
+				{Move: moves.ScoredMove{Move: moveOne, Score: 4.2}},
+			},
+			want: false,
+		},
+		// a forced checkmate
+		{
+			iterations: []moves.FailedMove{
+				{
+					Move: moves.ScoredMove{
+						Move:  moveOne,
+						Score: -moves.NewCheckmateScore(3),
+					},
+				},
+			},
+			want: true,
+		},
+		// no legal moves on a root
+		{
+			iterations: []moves.FailedMove{
+				{Error: errors.New("dummy")},
+			},
+			want: true,
+		},
+	} {
+		terminator := NewStabilityTerminator(3)
+		for index, iteration := range data.iterations {
+			terminator.CompleteIteration(index+1, iteration)
+		}
+
+		got := terminator.IsIterationTerminated(len(data.iterations) + 1)
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package terminators
+
+import (
+	"sync"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// SwitchableTerminator ...
+//
+// It forwards calls to a current terminator, which can be replaced
+// during a search, e.g. for converting an infinite search to a timed one.
+//
+// It supports the IterationTerminator and NodeCounter interfaces,
+// if a current terminator does.
+//
+// It's safe for concurrent use.
+type SwitchableTerminator struct {
+	lock       sync.RWMutex
+	terminator SearchTerminator
+}
+
+// NewSwitchableTerminator ...
+func NewSwitchableTerminator(
+	terminator SearchTerminator,
+) *SwitchableTerminator {
+	return &SwitchableTerminator{terminator: terminator}
+}
+
+// Switch ...
+func (switchable *SwitchableTerminator) Switch(terminator SearchTerminator) {
+	switchable.lock.Lock()
+	defer switchable.lock.Unlock()
+
+	switchable.terminator = terminator
+}
+
+// IsSearchTerminated ...
+func (switchable *SwitchableTerminator) IsSearchTerminated(deep int) bool {
+	return switchable.current().IsSearchTerminated(deep)
+}
+
+// SearchProgress ...
+func (switchable *SwitchableTerminator) SearchProgress(deep int) float64 {
+	return switchable.current().SearchProgress(deep)
+}
+
+// CountNode ...
+func (switchable *SwitchableTerminator) CountNode(deep int) {
+	CountNode(switchable.current(), deep)
+}
+
+// IsIterationTerminated ...
+func (switchable *SwitchableTerminator) IsIterationTerminated(
+	deep int,
+) bool {
+	return IsIterationTerminated(switchable.current(), deep)
+}
+
+// CompleteIteration ...
+func (switchable *SwitchableTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	CompleteIteration(switchable.current(), deep, move)
+}
+
+func (switchable *SwitchableTerminator) current() SearchTerminator {
+	switchable.lock.RLock()
+	defer switchable.lock.RUnlock()
+
+	return switchable.terminator
+}
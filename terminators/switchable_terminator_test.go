@@ -0,0 +1,85 @@
+package terminators
+
+import (
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+func TestNewSwitchableTerminator(test *testing.T) {
+	var innerTerminator MockSearchTerminator
+	terminator := NewSwitchableTerminator(innerTerminator)
+
+	if !reflect.DeepEqual(terminator.terminator, innerTerminator) {
+		test.Fail()
+	}
+}
+
+func TestSwitchableTerminatorSwitch(test *testing.T) {
+	terminator := NewSwitchableTerminator(new(ManualTerminator))
+	if terminator.IsSearchTerminated(2) {
+		test.Fail()
+	}
+
+	terminator.Switch(NewDeepTerminator(1))
+	if !terminator.IsSearchTerminated(2) {
+		test.Fail()
+	}
+}
+
+func TestSwitchableTerminatorSearchProgress(test *testing.T) {
+	terminator := NewSwitchableTerminator(MockSearchTerminator{
+		searchProgress: func(deep int) float64 {
+			if deep != 2 {
+				test.Fail()
+			}
+
+			return 0.25
+		},
+	})
+
+	if terminator.SearchProgress(2) != 0.25 {
+		test.Fail()
+	}
+}
+
+func TestSwitchableTerminatorIsIterationTerminated(test *testing.T) {
+	terminator := NewSwitchableTerminator(MockSearchTerminator{})
+	if terminator.IsIterationTerminated(2) {
+		test.Fail()
+	}
+
+	terminator.Switch(MockIterationTerminator{
+		isIterationTerminated: func(deep int) bool { return deep == 2 },
+	})
+	if !terminator.IsIterationTerminated(2) {
+		test.Fail()
+	}
+}
+
+func TestSwitchableTerminatorCompleteIteration(test *testing.T) {
+	var gotDeep int
+	terminator := NewSwitchableTerminator(MockIterationTerminator{
+		completeIteration: func(deep int, move moves.FailedMove) {
+			gotDeep = deep
+		},
+	})
+	terminator.CompleteIteration(2, moves.FailedMove{})
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
+
+func TestSwitchableTerminatorCountNode(test *testing.T) {
+	var gotDeep int
+	terminator := NewSwitchableTerminator(MockNodeCounter{
+		countNode: func(deep int) { gotDeep = deep },
+	})
+	terminator.CountNode(2)
+
+	if gotDeep != 2 {
+		test.Fail()
+	}
+}
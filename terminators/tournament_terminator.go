@@ -0,0 +1,166 @@
+package terminators
+
+import (
+	"sync"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+)
+
+// TournamentOptions ...
+type TournamentOptions struct {
+	RemainingTime time.Duration
+	Increment     time.Duration
+
+	// if it's zero, it's estimated by the move number;
+	// it shouldn't be negative
+	MovesToGo int
+
+	// it's a number of a current full move starting from one,
+	// i.e. it's incremented after a move of black
+	MoveNumber int
+
+	// it's a factor, by which a soft limit is multiplied, when a best move
+	// changes between iterations; if it's zero, 1.5 is used
+	ExtensionRatio float64
+}
+
+const (
+	defaultExtensionRatio = 1.5
+	estimatedGameLength   = 40
+	minimalMovesToGo      = 10
+	incrementUsageRatio   = 0.75
+	hardLimitRatio        = 4
+	maximalTimeUsageRatio = 0.75
+)
+
+// TournamentTerminator ...
+//
+// It manages time of a move in a game with a clock. It computes soft
+// and hard limits by remaining time, an increment and a number of moves
+// to a next time control. A search is aborted at the hard limit,
+// but IterativeSearcher doesn't start new iterations after the soft one.
+// The soft limit is extended, when a best move changes between iterations,
+// but never beyond the hard one.
+//
+// Its start time and hard limit are fixed, and its soft limit is guarded
+// by a lock, because in ParallelSearcher it's extended by a main worker,
+// while other workers check it concurrently.
+type TournamentTerminator struct {
+	clock     Clock
+	startTime time.Time
+	hardLimit time.Duration
+
+	lock           sync.RWMutex
+	softLimit      time.Duration
+	extensionRatio float64
+	lastMove       moves.FailedMove
+	hasLastMove    bool
+}
+
+// NewTournamentTerminator ...
+//
+// It panics, if the moves to go are negative.
+func NewTournamentTerminator(
+	clock Clock,
+	options TournamentOptions,
+) *TournamentTerminator {
+	movesToGo := options.MovesToGo
+	if movesToGo < 0 {
+		panic("negative moves to go")
+	}
+	if movesToGo == 0 {
+		movesToGo = estimatedGameLength - options.MoveNumber
+		if movesToGo < minimalMovesToGo {
+			movesToGo = minimalMovesToGo
+		}
+	}
+
+	extensionRatio := options.ExtensionRatio
+	if extensionRatio == 0 {
+		extensionRatio = defaultExtensionRatio
+	}
+
+	softLimit := options.RemainingTime/time.Duration(movesToGo) +
+		time.Duration(float64(options.Increment)*incrementUsageRatio)
+	hardLimit := minDuration(
+		softLimit*hardLimitRatio,
+		time.Duration(float64(options.RemainingTime)*maximalTimeUsageRatio),
+	)
+	softLimit = minDuration(softLimit, hardLimit)
+
+	startTime := clock()
+	return &TournamentTerminator{
+		clock:     clock,
+		startTime: startTime,
+		hardLimit: hardLimit,
+
+		softLimit:      softLimit,
+		extensionRatio: extensionRatio,
+	}
+}
+
+// SoftLimit ...
+func (terminator *TournamentTerminator) SoftLimit() time.Duration {
+	terminator.lock.RLock()
+	defer terminator.lock.RUnlock()
+
+	return terminator.softLimit
+}
+
+// HardLimit ...
+func (terminator *TournamentTerminator) HardLimit() time.Duration {
+	return terminator.hardLimit
+}
+
+// IsSearchTerminated ...
+func (terminator *TournamentTerminator) IsSearchTerminated(deep int) bool {
+	return terminator.duration() >= terminator.hardLimit
+}
+
+// SearchProgress ...
+func (terminator *TournamentTerminator) SearchProgress(deep int) float64 {
+	duration := terminator.duration()
+	if duration >= terminator.hardLimit {
+		return 1
+	}
+
+	return float64(duration) / float64(terminator.hardLimit)
+}
+
+// IsIterationTerminated ...
+func (terminator *TournamentTerminator) IsIterationTerminated(deep int) bool {
+	return terminator.duration() >= terminator.SoftLimit()
+}
+
+// CompleteIteration ...
+func (terminator *TournamentTerminator) CompleteIteration(
+	deep int,
+	move moves.FailedMove,
+) {
+	terminator.lock.Lock()
+	defer terminator.lock.Unlock()
+
+	if terminator.hasLastMove &&
+		move.Move.Move != terminator.lastMove.Move.Move {
+		softLimit := time.Duration(
+			float64(terminator.softLimit) * terminator.extensionRatio,
+		)
+		terminator.softLimit = minDuration(softLimit, terminator.hardLimit)
+	}
+
+	terminator.lastMove = move
+	terminator.hasLastMove = true
+}
+
+func (terminator *TournamentTerminator) duration() time.Duration {
+	return terminator.clock().Sub(terminator.startTime)
+}
+
+func minDuration(a time.Duration, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+
+	return b
+}
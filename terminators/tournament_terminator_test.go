@@ -0,0 +1,190 @@
+package terminators
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewTournamentTerminator(test *testing.T) {
+	type args struct {
+		options TournamentOptions
+	}
+	type data struct {
+		args               args
+		wantSoftLimit      time.Duration
+		wantHardLimit      time.Duration
+		wantExtensionRatio float64
+	}
+
+	for _, data := range []data{
+		// moves to go are estimated by the move number
+		{
+			args: args{
+				options: TournamentOptions{
+					RemainingTime: time.Minute,
+					Increment:     2 * time.Second,
+					MoveNumber:    10,
+				},
+			},
+			wantSoftLimit:      3500 * time.Millisecond,
+			wantHardLimit:      14 * time.Second,
+			wantExtensionRatio: 1.5,
+		},
+		// moves to go are estimated for a long game
+		{
+			args: args{
+				options: TournamentOptions{
+					RemainingTime: 10 * time.Second,
+					MoveNumber:    50,
+				},
+			},
+			wantSoftLimit:      time.Second,
+			wantHardLimit:      4 * time.Second,
+			wantExtensionRatio: 1.5,
+		},
+		// the hard limit is limited by remaining time
+		{
+			args: args{
+				options: TournamentOptions{
+					RemainingTime:  10 * time.Second,
+					MovesToGo:      5,
+					ExtensionRatio: 2,
+				},
+			},
+			wantSoftLimit:      2 * time.Second,
+			wantHardLimit:      7500 * time.Millisecond,
+			wantExtensionRatio: 2,
+		},
+		// the soft limit is limited by the hard one
+		{
+			args: args{
+				options: TournamentOptions{
+					RemainingTime: time.Second,
+					MovesToGo:     1,
+				},
+			},
+			wantSoftLimit:      750 * time.Millisecond,
+			wantHardLimit:      750 * time.Millisecond,
+			wantExtensionRatio: 1.5,
+		},
+	} {
+		terminator := NewTournamentTerminator(clock, data.args.options)
+
+		if !terminator.startTime.Equal(clock()) {
+			test.Fail()
+		}
+		if terminator.SoftLimit() != data.wantSoftLimit {
+			test.Fail()
+		}
+		if terminator.HardLimit() != data.wantHardLimit {
+			test.Fail()
+		}
+		if terminator.extensionRatio != data.wantExtensionRatio {
+			test.Fail()
+		}
+	}
+}
+
+func TestNewTournamentTerminator_withNegativeMovesToGo(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		NewTournamentTerminator(clock, TournamentOptions{
+			RemainingTime: time.Minute,
+			MovesToGo:     -1,
+		})
+	}()
+
+	if err != "negative moves to go" {
+		test.Fail()
+	}
+}
+
+func TestTournamentTerminator(test *testing.T) {
+	var lock sync.Mutex
+	currentTime := clock()
+	fakeClock := func() time.Time {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return currentTime
+	}
+	sleep := func(duration time.Duration) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		currentTime = currentTime.Add(duration)
+	}
+
+	// the soft limit is 2s, the hard one is 8s
+	terminator := NewTournamentTerminator(fakeClock, TournamentOptions{
+		RemainingTime: 20 * time.Second,
+		MovesToGo:     10,
+	})
+	moveOne := moves.FailedMove{
+		Move: moves.ScoredMove{
+			Move: models.Move{
+				Start:  models.Position{File: 1, Rank: 2},
+				Finish: models.Position{File: 3, Rank: 4},
+			},
+		},
+	}
+	moveTwo := moves.FailedMove{
+		Move: moves.ScoredMove{
+			Move: models.Move{
+				Start:  models.Position{File: 5, Rank: 6},
+				Finish: models.Position{File: 7, Rank: 8},
+			},
+		},
+	}
+
+	sleep(time.Second)
+	terminator.CompleteIteration(1, moveOne)
+	if terminator.IsIterationTerminated(2) || terminator.IsSearchTerminated(2) {
+		test.Fail()
+	}
+	if terminator.SearchProgress(2) != 0.125 {
+		test.Fail()
+	}
+
+	// the same move doesn't extend the soft limit
+	sleep(time.Second)
+	terminator.CompleteIteration(2, moveOne)
+	if terminator.SoftLimit() != 2*time.Second {
+		test.Fail()
+	}
+	if !terminator.IsIterationTerminated(3) || terminator.IsSearchTerminated(3) {
+		test.Fail()
+	}
+
+	// a changed move extends the soft limit
+	terminator.CompleteIteration(2, moveTwo)
+	if terminator.SoftLimit() != 3*time.Second {
+		test.Fail()
+	}
+	if terminator.IsIterationTerminated(3) {
+		test.Fail()
+	}
+
+	// the soft limit is limited by the hard one
+	for i := 0; i < 5; i++ {
+		terminator.CompleteIteration(3, moveOne)
+		terminator.CompleteIteration(3, moveTwo)
+	}
+	if terminator.SoftLimit() != 8*time.Second {
+		test.Fail()
+	}
+
+	sleep(6 * time.Second)
+	if !terminator.IsIterationTerminated(4) || !terminator.IsSearchTerminated(4) {
+		test.Fail()
+	}
+	if terminator.SearchProgress(4) != 1 {
+		test.Fail()
+	}
+}
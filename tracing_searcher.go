@@ -0,0 +1,393 @@
+package chessminimax
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/observers"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// TraceNode ...
+//
+// A root node is virtual: its children are nodes of top-level calls.
+type TraceNode struct {
+	Move     models.Move
+	Deep     int
+	Bounds   moves.Bounds
+	Score    float64
+	Cutoff   bool
+	CacheHit bool
+	Error    error
+	Children []*TraceNode
+}
+
+type jsonTraceNode struct {
+	Move     string           `json:"move,omitempty"`
+	Deep     int              `json:"deep"`
+	Alpha    jsonScore        `json:"alpha"`
+	Beta     jsonScore        `json:"beta"`
+	Score    jsonScore        `json:"score"`
+	Cutoff   bool             `json:"cutoff,omitempty"`
+	CacheHit bool             `json:"cache_hit,omitempty"`
+	Error    string           `json:"error,omitempty"`
+	Children []*jsonTraceNode `json:"children,omitempty"`
+}
+
+// infinite bounds are unsupported by JSON, so they are encoded as strings
+type jsonScore float64
+
+// TracingSearcher ...
+//
+// It records a tree explored by the inner searcher up to the specified number
+// of nodes; further nodes are searched, but not recorded. Cache hits
+// and cutoffs are detected via events of the inner searcher
+// (see ObservableSearcher).
+//
+// It passes to the inner searcher a storage that remembers an applied move,
+// so the inner searcher shouldn't depend on a concrete type of a storage.
+//
+// It isn't safe for concurrent use, so it shouldn't be used
+// with parallel searchers.
+type TracingSearcher struct {
+	*SearcherSetter
+	*ObserverSetter
+
+	rootSearcher MoveSearcher
+	trace        *searchTrace
+}
+
+type searchTrace struct {
+	root         *TraceNode
+	stack        []*TraceNode
+	nodeCount    int
+	maximalNodes int
+	isTruncated  bool
+}
+
+type tracedStorage struct {
+	models.PieceStorage
+
+	move models.Move
+}
+
+// NewTracingSearcher ...
+//
+// It inserts itself between a recursive searcher and its current inner one
+// (itself for AlphaBetaSearcher or bound CachedSearcher), so recursive calls
+// are traced too. The recursive searcher is the passed one or, if
+// CachedSearcher is passed, a searcher bound to it. Top-level calls are passed
+// to the passed searcher, so a search should be started via the tracer
+// in order to trace a root node, and its results are same as without
+// the tracer.
+func NewTracingSearcher(
+	searcher MoveSearcher,
+	maximalNodes int,
+) TracingSearcher {
+	tracer := TracingSearcher{
+		SearcherSetter: new(SearcherSetter),
+		ObserverSetter: new(ObserverSetter),
+
+		rootSearcher: searcher,
+		trace:        &searchTrace{maximalNodes: maximalNodes},
+	}
+	tracer.Reset()
+
+	// CachedSearcher only delegates to a bound searcher, so the tracer can't be
+	// its inner one: the tracer would delegate back to it
+	recursiveSearcher := searcher
+	if cachedSearcher, ok := searcher.(CachedSearcher); ok {
+		recursiveSearcher = cachedSearcher.searcher
+	}
+
+	innerSearcher := recursiveSearcher
+	if boundSearcher, ok := recursiveSearcher.(interface {
+		innerSearcher() MoveSearcher
+	}); ok {
+		innerSearcher = boundSearcher.innerSearcher()
+	}
+
+	// it should be done before rewiring, so CachedSearcher and a searcher
+	// bound to it forward the observer to each other, but not to the tracer
+	setObserver(searcher, tracer)
+
+	tracer.SetSearcher(innerSearcher)
+	recursiveSearcher.SetSearcher(tracer)
+
+	return tracer
+}
+
+// SetObserver ...
+//
+// It sets an observer, to which events of the inner searcher are forwarded.
+func (tracer TracingSearcher) SetObserver(observer observers.SearchObserver) {
+	tracer.ObserverSetter.SetObserver(observer)
+}
+
+// SetTerminator ...
+func (tracer TracingSearcher) SetTerminator(
+	terminator terminators.SearchTerminator,
+) {
+	tracer.rootSearcher.SetTerminator(terminator)
+}
+
+// SearchProgress ...
+func (tracer TracingSearcher) SearchProgress(deep int) float64 {
+	return tracer.rootSearcher.SearchProgress(deep)
+}
+
+// Trace ...
+//
+// It returns a virtual root node of a recorded tree.
+func (tracer TracingSearcher) Trace() *TraceNode {
+	return tracer.trace.root
+}
+
+// IsTruncated ...
+//
+// It returns true, if some nodes weren't recorded because of the limit.
+func (tracer TracingSearcher) IsTruncated() bool {
+	return tracer.trace.isTruncated
+}
+
+// Reset ...
+//
+// It clears a recorded tree.
+func (tracer TracingSearcher) Reset() {
+	root := &TraceNode{Deep: -1, Bounds: moves.NewBounds()}
+	*tracer.trace = searchTrace{
+		root:         root,
+		stack:        []*TraceNode{root},
+		maximalNodes: tracer.trace.maximalNodes,
+	}
+}
+
+// SearchMove ...
+func (tracer TracingSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	traced, ok := storage.(tracedStorage)
+	if !ok {
+		traced = tracedStorage{PieceStorage: storage}
+	}
+
+	searcher := tracer.searcher
+	if tracer.trace.isTopLevel() {
+		searcher = tracer.rootSearcher
+	}
+
+	node := tracer.trace.push(traced.move, deep, bounds)
+	move, err := searcher.SearchMove(traced, color, deep, bounds)
+	tracer.trace.pop()
+
+	if node != nil {
+		node.Score = move.Score
+		node.Error = err
+	}
+
+	return move, err
+}
+
+// IterationStarted ...
+func (tracer TracingSearcher) IterationStarted(deep int) {
+	tracer.currentObserver().IterationStarted(deep)
+}
+
+// IterationFinished ...
+func (tracer TracingSearcher) IterationFinished(
+	deep int,
+	move moves.FailedMove,
+) {
+	tracer.currentObserver().IterationFinished(deep, move)
+}
+
+// RootMoveStarted ...
+func (tracer TracingSearcher) RootMoveStarted(move models.Move, number int) {
+	tracer.currentObserver().RootMoveStarted(move, number)
+}
+
+// BestRootMoveChanged ...
+func (tracer TracingSearcher) BestRootMoveChanged(move moves.ScoredMove) {
+	tracer.currentObserver().BestRootMoveChanged(move)
+}
+
+// CacheHit ...
+func (tracer TracingSearcher) CacheHit(deep int) {
+	if node := tracer.trace.top(); node != nil {
+		node.CacheHit = true
+	}
+
+	tracer.currentObserver().CacheHit(deep)
+}
+
+// Cutoff ...
+func (tracer TracingSearcher) Cutoff(deep int, move models.Move) {
+	if node := tracer.trace.top(); node != nil {
+		node.Cutoff = true
+	}
+
+	tracer.currentObserver().Cutoff(deep, move)
+}
+
+// it returns nil, if the node isn't recorded because of the limit
+func (trace *searchTrace) push(
+	move models.Move,
+	deep int,
+	bounds moves.Bounds,
+) *TraceNode {
+	var node *TraceNode
+	parent := trace.top()
+	if parent != nil && trace.nodeCount < trace.maximalNodes {
+		node = &TraceNode{Move: move, Deep: deep, Bounds: bounds}
+		parent.Children = append(parent.Children, node)
+		trace.nodeCount++
+	} else {
+		trace.isTruncated = true
+	}
+
+	// unrecorded nodes are pushed too, so the stack corresponds to a search
+	trace.stack = append(trace.stack, node)
+	return node
+}
+
+func (trace *searchTrace) pop() {
+	trace.stack = trace.stack[:len(trace.stack)-1]
+}
+
+func (trace *searchTrace) isTopLevel() bool {
+	// there is only a virtual root
+	return len(trace.stack) == 1
+}
+
+func (trace *searchTrace) top() *TraceNode {
+	return trace.stack[len(trace.stack)-1]
+}
+
+// ApplyMove ...
+func (storage tracedStorage) ApplyMove(move models.Move) models.PieceStorage {
+	nextStorage := storage.PieceStorage.ApplyMove(move)
+	return tracedStorage{PieceStorage: nextStorage, move: move}
+}
+
+// WriteDOT ...
+//
+// It writes a tree in the Graphviz DOT format.
+func (node *TraceNode) WriteDOT(writer io.Writer) error {
+	if _, err := io.WriteString(writer, "digraph trace {\n"); err != nil {
+		return err
+	}
+
+	var index int
+	if err := node.writeDOTNode(writer, &index); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(writer, "}\n")
+	return err
+}
+
+// MarshalJSON ...
+func (node *TraceNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(node.toJSON())
+}
+
+// the index is an identifier of a next written node
+func (node *TraceNode) writeDOTNode(writer io.Writer, index *int) error {
+	id := *index
+	*index++
+
+	style := ""
+	switch {
+	case node.Cutoff:
+		style = ", style=filled, fillcolor=lightcoral"
+	case node.CacheHit:
+		style = ", style=filled, fillcolor=lightblue"
+	}
+	_, err := fmt.Fprintf(
+		writer,
+		"  n%d [label=%q%s];\n",
+		id,
+		node.label(),
+		style,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range node.Children {
+		childID := *index
+		if err := child.writeDOTNode(writer, index); err != nil {
+			return err
+		}
+
+		_, err := fmt.Fprintf(writer, "  n%d -> n%d;\n", id, childID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (node *TraceNode) label() string {
+	if node.Deep < 0 {
+		return "root"
+	}
+
+	label := fmt.Sprintf(
+		"%s\ndeep %d [%s, %s]\nscore %s",
+		observers.EncodeMove(node.Move),
+		node.Deep,
+		formatScore(node.Bounds.Alpha),
+		formatScore(node.Bounds.Beta),
+		formatScore(node.Score),
+	)
+	if node.Error != nil {
+		label += "\n" + node.Error.Error()
+	}
+
+	return label
+}
+
+func (node *TraceNode) toJSON() *jsonTraceNode {
+	jsonNode := &jsonTraceNode{
+		Deep:     node.Deep,
+		Alpha:    jsonScore(node.Bounds.Alpha),
+		Beta:     jsonScore(node.Bounds.Beta),
+		Score:    jsonScore(node.Score),
+		Cutoff:   node.Cutoff,
+		CacheHit: node.CacheHit,
+	}
+	if !node.Move.IsZero() {
+		jsonNode.Move = observers.EncodeMove(node.Move)
+	}
+	if node.Error != nil {
+		jsonNode.Error = node.Error.Error()
+	}
+	for _, child := range node.Children {
+		jsonNode.Children = append(jsonNode.Children, child.toJSON())
+	}
+
+	return jsonNode
+}
+
+// MarshalJSON ...
+func (score jsonScore) MarshalJSON() ([]byte, error) {
+	if math.IsInf(float64(score), 0) {
+		return json.Marshal(formatScore(float64(score)))
+	}
+
+	return json.Marshal(float64(score))
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
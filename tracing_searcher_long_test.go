@@ -0,0 +1,170 @@
+// +build long
+
+package chessminimax
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/caches"
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestTracingSearcher(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"kn6/n6q/PP6/8/8/8/7P/7K",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	innerSearcher := NewAlphaBetaSearcher(
+		generator,
+		nil, // terminator will be set automatically by the iterative searcher
+		evaluator,
+	)
+
+	cache := caches.NewStringHashingCache(1e6, uci.EncodePieceStorage)
+	// make and bind a cached searcher to inner one
+	NewCachedSearcher(innerSearcher, cache)
+	tracer := NewTracingSearcher(innerSearcher, 1e6)
+	searcher := NewIterativeSearcher(tracer, terminators.NewDeepTerminator(4))
+
+	gotMove, gotErr :=
+		searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+
+	wantMove := models.Move{
+		Start:  models.Position{File: 1, Rank: 5},
+		Finish: models.Position{File: 1, Rank: 6},
+	}
+	if gotMove.Move != wantMove {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+
+	// a root node per iteration
+	if len(tracer.Trace().Children) != 4 {
+		test.Fail()
+	}
+	if tracer.IsTruncated() {
+		test.Fail()
+	}
+
+	var cacheHits, cutoffs int
+	var countNodes func(node *TraceNode)
+	countNodes = func(node *TraceNode) {
+		if node.CacheHit {
+			cacheHits++
+		}
+		if node.Cutoff {
+			cutoffs++
+		}
+
+		for _, child := range node.Children {
+			countNodes(child)
+		}
+	}
+	countNodes(tracer.Trace())
+
+	if cacheHits == 0 || cutoffs == 0 {
+		test.Fail()
+	}
+
+	var buffer bytes.Buffer
+	if err := tracer.Trace().WriteDOT(&buffer); err != nil {
+		test.Fail()
+	}
+	if _, err := json.Marshal(tracer.Trace()); err != nil {
+		test.Fail()
+	}
+}
+
+func TestTracingSearcher_withCachedSearcher(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"kn6/n6q/PP6/8/8/8/7P/7K",
+		pieces.NewPiece,
+		models.NewBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	makeSearcher := func() CachedSearcher {
+		var generator models.MoveGenerator
+		var evaluator evaluators.MaterialEvaluator
+		innerSearcher := NewAlphaBetaSearcher(
+			generator,
+			nil, // terminator will be set automatically by the iterative searcher
+			evaluator,
+		)
+
+		cache := caches.NewStringHashingCache(1e6, uci.EncodePieceStorage)
+		return NewCachedSearcher(innerSearcher, cache)
+	}
+
+	// results with the tracer should be same as without it
+	wantMove, wantErr := NewIterativeSearcher(
+		makeSearcher(),
+		terminators.NewDeepTerminator(4),
+	).SearchMove(storage, models.White, 0, moves.NewBounds())
+
+	tracer := NewTracingSearcher(makeSearcher(), 1e6)
+	searcher := NewIterativeSearcher(tracer, terminators.NewDeepTerminator(4))
+
+	gotMove, gotErr :=
+		searcher.SearchMove(storage, models.White, 0, moves.NewBounds())
+
+	if !reflect.DeepEqual(gotMove, wantMove) {
+		test.Fail()
+	}
+	if gotErr != wantErr {
+		test.Fail()
+	}
+
+	// a root node per iteration, so a root isn't pushed twice
+	if len(tracer.Trace().Children) != 4 {
+		test.Fail()
+	}
+	if tracer.IsTruncated() {
+		test.Fail()
+	}
+
+	var cacheHits int
+	var checkNodes func(node *TraceNode)
+	checkNodes = func(node *TraceNode) {
+		if node.CacheHit {
+			cacheHits++
+
+			// a hit node is answered by the cache, so it isn't expanded
+			if len(node.Children) != 0 {
+				test.Fail()
+			}
+		}
+
+		for _, child := range node.Children {
+			if child.Deep != node.Deep+1 {
+				test.Fail()
+			}
+
+			checkNodes(child)
+		}
+	}
+	checkNodes(tracer.Trace())
+
+	if cacheHits == 0 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,339 @@
+package chessminimax
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+func TestNewTracingSearcher(test *testing.T) {
+	innerSearcher := NewAlphaBetaSearcher(
+		MockMoveGenerator{},
+		MockSearchTerminator{},
+		MockBoardEvaluator{},
+	)
+	cachedSearcher := NewCachedSearcher(innerSearcher, MockCache{})
+	tracer := NewTracingSearcher(innerSearcher, 10)
+
+	if _, ok := innerSearcher.searcher.(TracingSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := tracer.searcher.(CachedSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := tracer.rootSearcher.(AlphaBetaSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := cachedSearcher.searcher.(AlphaBetaSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := innerSearcher.observer.(TracingSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := cachedSearcher.observer.(TracingSearcher); !ok {
+		test.Fail()
+	}
+	if tracer.trace.maximalNodes != 10 {
+		test.Fail()
+	}
+	if tracer.Trace().Deep != -1 || len(tracer.Trace().Children) != 0 {
+		test.Fail()
+	}
+}
+
+func TestNewTracingSearcher_withCachedSearcher(test *testing.T) {
+	innerSearcher := NewAlphaBetaSearcher(
+		MockMoveGenerator{},
+		MockSearchTerminator{},
+		MockBoardEvaluator{},
+	)
+	cachedSearcher := NewCachedSearcher(innerSearcher, MockCache{})
+	tracer := NewTracingSearcher(cachedSearcher, 10)
+
+	if _, ok := innerSearcher.searcher.(TracingSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := tracer.searcher.(CachedSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := tracer.rootSearcher.(CachedSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := cachedSearcher.searcher.(AlphaBetaSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := innerSearcher.observer.(TracingSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := cachedSearcher.observer.(TracingSearcher); !ok {
+		test.Fail()
+	}
+	if _, ok := tracer.observer.(TracingSearcher); ok {
+		test.Fail()
+	}
+}
+
+func TestTracingSearcherSearchMove(test *testing.T) {
+	type data struct {
+		maximalNodes  int
+		wantTrace     *TraceNode
+		wantTruncated bool
+	}
+
+	infinity := math.Inf(+1)
+	fullTrace := &TraceNode{
+		Deep:   -1,
+		Bounds: moves.NewBounds(),
+		Children: []*TraceNode{
+			{
+				Deep:   0,
+				Bounds: moves.NewBounds(),
+				Score:  1,
+				Children: []*TraceNode{
+					{
+						Move:   models.Move{Start: models.Position{File: 0}},
+						Deep:   1,
+						Bounds: moves.NewBounds(),
+						Score:  -1,
+						Children: []*TraceNode{
+							{
+								Move:   models.Move{Start: models.Position{File: 0}},
+								Deep:   2,
+								Bounds: moves.NewBounds(),
+								Score:  1,
+							},
+							{
+								Move:   models.Move{Start: models.Position{File: 1}},
+								Deep:   2,
+								Bounds: moves.Bounds{Alpha: -infinity, Beta: 1},
+								Score:  2,
+							},
+						},
+					},
+					{
+						Move:   models.Move{Start: models.Position{File: 1}},
+						Deep:   1,
+						Bounds: moves.Bounds{Alpha: -infinity, Beta: -1},
+						Score:  0,
+						Cutoff: true,
+						Children: []*TraceNode{
+							{
+								Move:   models.Move{Start: models.Position{File: 0}},
+								Deep:   2,
+								Bounds: moves.Bounds{Alpha: 1, Beta: infinity},
+								Score:  3,
+							},
+							{
+								Move:   models.Move{Start: models.Position{File: 1}},
+								Deep:   2,
+								Bounds: moves.Bounds{Alpha: 1, Beta: 3},
+								Score:  0,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	truncatedTrace := &TraceNode{
+		Deep:   -1,
+		Bounds: moves.NewBounds(),
+		Children: []*TraceNode{
+			{
+				Deep:   0,
+				Bounds: moves.NewBounds(),
+				Score:  1,
+				Children: []*TraceNode{
+					{
+						Move:   models.Move{Start: models.Position{File: 0}},
+						Deep:   1,
+						Bounds: moves.NewBounds(),
+						Score:  -1,
+						Children: []*TraceNode{
+							{
+								Move:   models.Move{Start: models.Position{File: 0}},
+								Deep:   2,
+								Bounds: moves.NewBounds(),
+								Score:  1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, data := range []data{
+		{
+			maximalNodes:  10,
+			wantTrace:     fullTrace,
+			wantTruncated: false,
+		},
+		{
+			maximalNodes:  3,
+			wantTrace:     truncatedTrace,
+			wantTruncated: true,
+		},
+	} {
+		generator := MockMoveGenerator{
+			movesForColor: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) ([]models.Move, error) {
+				moveGroup := []models.Move{
+					{Start: models.Position{File: 0}},
+					{Start: models.Position{File: 1}},
+				}
+				return moveGroup, nil
+			},
+		}
+		evaluator := MockBoardEvaluator{
+			evaluateBoard: func(
+				storage models.PieceStorage,
+				color models.Color,
+			) float64 {
+				innerStorage := storage.(tracedStorage).PieceStorage
+				scores := map[string]float64{"aa": 1, "ab": 2, "ba": 3, "bb": 0}
+				return scores[innerStorage.(MockTreeStorage).path]
+			},
+		}
+		innerSearcher := NewAlphaBetaSearcher(
+			generator,
+			terminators.NewDeepTerminator(2),
+			evaluator,
+		)
+
+		tracer := NewTracingSearcher(innerSearcher, data.maximalNodes)
+		gotMove, gotErr := tracer.SearchMove(
+			MockTreeStorage{},
+			models.White,
+			0,
+			moves.NewBounds(),
+		)
+
+		wantMove := moves.ScoredMove{
+			Move:    models.Move{Start: models.Position{File: 0}},
+			Score:   1,
+			Quality: 1,
+		}
+		if !reflect.DeepEqual(gotMove, wantMove) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(tracer.Trace(), data.wantTrace) {
+			test.Fail()
+		}
+		if tracer.IsTruncated() != data.wantTruncated {
+			test.Fail()
+		}
+
+		tracer.Reset()
+		if len(tracer.Trace().Children) != 0 || tracer.IsTruncated() {
+			test.Fail()
+		}
+	}
+}
+
+func TestTracingSearcherCacheHit(test *testing.T) {
+	var events []string
+	tracer := TracingSearcher{
+		ObserverSetter: &ObserverSetter{
+			observer: MockSearchObserver{events: &events},
+		},
+
+		trace: &searchTrace{maximalNodes: 10},
+	}
+	tracer.Reset()
+	tracer.trace.push(models.Move{}, 0, moves.NewBounds())
+	tracer.CacheHit(0)
+
+	if !tracer.Trace().Children[0].CacheHit {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(events, []string{"CacheHit(0)"}) {
+		test.Fail()
+	}
+}
+
+func TestTraceNodeWriteDOT(test *testing.T) {
+	trace := &TraceNode{
+		Deep:   -1,
+		Bounds: moves.NewBounds(),
+		Children: []*TraceNode{
+			{
+				Move: models.Move{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 4, Rank: 3},
+				},
+				Deep:   1,
+				Bounds: moves.Bounds{Alpha: math.Inf(-1), Beta: 2.5},
+				Score:  3,
+				Cutoff: true,
+			},
+			{
+				Deep:     1,
+				Bounds:   moves.NewBounds(),
+				CacheHit: true,
+				Error:    ErrDraw,
+			},
+		},
+	}
+
+	var buffer bytes.Buffer
+	err := trace.WriteDOT(&buffer)
+
+	wantDOT := "digraph trace {\n" +
+		"  n0 [label=\"root\"];\n" +
+		"  n1 [label=\"e2e4\\ndeep 1 [-Inf, 2.5]\\nscore 3\", " +
+		"style=filled, fillcolor=lightcoral];\n" +
+		"  n0 -> n1;\n" +
+		"  n2 [label=\"a1a1\\ndeep 1 [-Inf, +Inf]\\nscore 0\\ndraw\", " +
+		"style=filled, fillcolor=lightblue];\n" +
+		"  n0 -> n2;\n" +
+		"}\n"
+	if got := buffer.String(); got != wantDOT {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestTraceNodeMarshalJSON(test *testing.T) {
+	trace := &TraceNode{
+		Deep:   -1,
+		Bounds: moves.NewBounds(),
+		Children: []*TraceNode{
+			{
+				Move: models.Move{
+					Start:  models.Position{File: 4, Rank: 1},
+					Finish: models.Position{File: 4, Rank: 3},
+				},
+				Deep:     1,
+				Bounds:   moves.Bounds{Alpha: math.Inf(-1), Beta: 2.5},
+				Score:    3,
+				Cutoff:   true,
+				CacheHit: true,
+				Error:    ErrCheckmate,
+			},
+		},
+	}
+	got, err := json.Marshal(trace)
+
+	wantJSON := `{"deep":-1,"alpha":"-Inf","beta":"+Inf","score":0,` +
+		`"children":[{"move":"e2e4","deep":1,"alpha":"-Inf","beta":2.5,` +
+		`"score":3,"cutoff":true,"cache_hit":true,"error":"checkmate"}]}`
+	if string(got) != wantJSON {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,242 @@
+package chessminimax
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// it's used only inside YBWCSearcher and is never returned from it
+var errSearchCancelled = errors.New("search cancelled")
+
+// YBWCSearcher ...
+//
+// It implements alpha-beta pruning parallelized by the Young Brothers Wait
+// Concept: in every node a first legal move is searched serially, then other
+// ones are searched in parallel with shared bounds. A cutoff in a node cancels
+// searches of its remaining moves.
+//
+// A number of additional goroutines is limited by a pool; if it's exhausted,
+// moves are searched in a current goroutine.
+type YBWCSearcher struct {
+	*TerminatorSetter
+
+	generator MoveGenerator
+	evaluator evaluators.BoardEvaluator
+	pool      chan struct{}
+}
+
+type splitPoint struct {
+	parent *splitPoint
+
+	cancellationFlag uint64
+
+	lock     sync.Mutex
+	bounds   moves.Bounds
+	bestMove moves.ScoredMove
+	cutoff   *moves.ScoredMove
+	hasCheck bool
+}
+
+// NewYBWCSearcher ...
+//
+// The concurrency is a maximal number of goroutines searching in parallel,
+// including a calling one.
+func NewYBWCSearcher(
+	generator MoveGenerator,
+	terminator terminators.SearchTerminator,
+	evaluator evaluators.BoardEvaluator,
+	concurrency int,
+) YBWCSearcher {
+	searcher := YBWCSearcher{
+		TerminatorSetter: new(TerminatorSetter),
+
+		generator: generator,
+		evaluator: evaluator,
+	}
+	if concurrency > 1 {
+		searcher.pool = make(chan struct{}, concurrency-1)
+	}
+
+	searcher.SetTerminator(terminator)
+
+	return searcher
+}
+
+// SetSearcher ...
+//
+// It does nothing and is required only for correspondence
+// to the MoveSearcher interface.
+//
+// It always panics.
+func (YBWCSearcher) SetSearcher(innerSearcher MoveSearcher) {
+	panic("not supported")
+}
+
+// SearchMove ...
+func (searcher YBWCSearcher) SearchMove(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+) (moves.ScoredMove, error) {
+	return searcher.searchNode(storage, color, deep, bounds, nil)
+}
+
+func (searcher YBWCSearcher) searchNode(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	bounds moves.Bounds,
+	parent *splitPoint,
+) (moves.ScoredMove, error) {
+	// check for a check should be first, including before a termination check,
+	// because a terminated evaluation doesn't make sense for a check position
+	moveGroup, err := searcher.generator.MovesForColor(storage, color)
+	if err != nil {
+		return moves.ScoredMove{}, err
+	}
+
+	terminators.CountNode(searcher.terminator, deep)
+	if ok := searcher.terminator.IsSearchTerminated(deep); ok {
+		score := searcher.evaluator.EvaluateBoard(storage, color)
+		return moves.ScoredMove{Score: score}, nil
+	}
+
+	// it's impossible to find a shorter checkmate on a root
+	if deep != 0 {
+		if score, ok := pruneMateDistance(bounds, deep); ok {
+			return moves.ScoredMove{Score: score}, nil
+		}
+	}
+
+	node := &splitPoint{
+		parent:   parent,
+		bounds:   bounds,
+		bestMove: moves.NewScoredMove(),
+	}
+	moveQuality := evaluateQuality(searcher, deep)
+
+	// search an eldest brother serially
+	var youngerMoves []models.Move
+	for index, move := range moveGroup {
+		if node.isCancelled() {
+			return moves.ScoredMove{}, errSearchCancelled
+		}
+
+		searcher.searchChild(storage, color, deep, move, moveQuality, node)
+		if node.cutoff != nil {
+			return *node.cutoff, nil
+		}
+		if node.bestMove.IsUpdated() {
+			youngerMoves = moveGroup[index+1:]
+			break
+		}
+	}
+
+	// search younger brothers in parallel
+	var waiter sync.WaitGroup
+	for _, move := range youngerMoves {
+		if node.isCancelled() {
+			break
+		}
+
+		select {
+		case searcher.pool <- struct{}{}:
+			waiter.Add(1)
+			go func(move models.Move) {
+				defer waiter.Done()
+				defer func() { <-searcher.pool }()
+
+				searcher.searchChild(storage, color, deep, move, moveQuality, node)
+			}(move)
+		default:
+			searcher.searchChild(storage, color, deep, move, moveQuality, node)
+		}
+	}
+	waiter.Wait()
+
+	// it's after waiting, so the node is accessed without the lock
+	if node.cutoff != nil {
+		return *node.cutoff, nil
+	}
+	// a result of a cancelled search is incomplete
+	if node.parent.isCancelled() {
+		return moves.ScoredMove{}, errSearchCancelled
+	}
+
+	// has a legal move
+	if node.bestMove.IsUpdated() {
+		return node.bestMove, nil
+	}
+
+	// hasn't a legal move
+	if node.hasCheck && isKingUnderAttack(searcher.generator, storage, color) {
+		score := evaluateCheckmate(deep)
+		return moves.ScoredMove{Score: score}, ErrCheckmate
+	}
+
+	// score of a draw is a null
+	return moves.ScoredMove{}, ErrDraw
+}
+
+func (searcher YBWCSearcher) searchChild(
+	storage models.PieceStorage,
+	color models.Color,
+	deep int,
+	move models.Move,
+	moveQuality float64,
+	node *splitPoint,
+) {
+	node.lock.Lock()
+	nextBounds := node.bounds.Next()
+	node.lock.Unlock()
+
+	nextStorage := storage.ApplyMove(move)
+	nextColor := color.Negative()
+	nextDeep := deep + 1
+	scoredMove, err :=
+		searcher.searchNode(nextStorage, nextColor, nextDeep, nextBounds, node)
+	if err == errSearchCancelled {
+		return
+	}
+
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	if err == models.ErrKingCapture {
+		node.hasCheck = true
+		return
+	}
+	// other brothers may have already caused a cutoff
+	if node.cutoff != nil {
+		return
+	}
+
+	scoredMove, ok := node.bounds.Update(scoredMove, move, moveQuality)
+	if !ok {
+		node.cutoff = &scoredMove
+		atomic.StoreUint64(&node.cancellationFlag, 1)
+
+		return
+	}
+
+	node.bestMove.Update(scoredMove, move, moveQuality)
+}
+
+// it checks, if a search in this split point or in any of its ancestors
+// was cancelled by a cutoff
+func (node *splitPoint) isCancelled() bool {
+	for ; node != nil; node = node.parent {
+		if atomic.LoadUint64(&node.cancellationFlag) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package chessminimax
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-minimax/evaluators"
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func BenchmarkYBWCSearcher_1Ply(benchmark *testing.B) {
+	for i := 0; i < benchmark.N; i++ {
+		ybwcSearch(initial, models.White, 1) // nolint: errcheck
+	}
+}
+
+func BenchmarkYBWCSearcher_2Ply(benchmark *testing.B) {
+	for i := 0; i < benchmark.N; i++ {
+		ybwcSearch(initial, models.White, 2) // nolint: errcheck
+	}
+}
+
+func BenchmarkYBWCSearcher_3Ply(benchmark *testing.B) {
+	for i := 0; i < benchmark.N; i++ {
+		ybwcSearch(initial, models.White, 3) // nolint: errcheck
+	}
+}
+
+func ybwcSearch(
+	boardInFEN string,
+	color models.Color,
+	maximalDeep int,
+) (moves.ScoredMove, error) {
+	storage, err :=
+		uci.DecodePieceStorage(boardInFEN, pieces.NewPiece, models.NewBoard)
+	if err != nil {
+		return moves.ScoredMove{}, err
+	}
+
+	var generator models.MoveGenerator
+	var evaluator evaluators.MaterialEvaluator
+	terminator := terminators.NewDeepTerminator(maximalDeep)
+	searcher :=
+		NewYBWCSearcher(generator, terminator, evaluator, runtime.NumCPU())
+
+	return searcher.SearchMove(
+		storage,
+		color,
+		0, // initial deep
+		moves.NewBounds(),
+	)
+}
@@ -0,0 +1,127 @@
+package chessminimax
+
+import (
+	"hash/fnv"
+	"reflect"
+	"testing"
+
+	moves "github.com/thewizardplusplus/go-chess-minimax/models"
+	"github.com/thewizardplusplus/go-chess-minimax/terminators"
+	models "github.com/thewizardplusplus/go-chess-models"
+)
+
+// it's a node of a synthetic game tree identified by a path of moves
+type MockTreeStorage struct {
+	MockPieceStorage
+
+	path string
+}
+
+func (storage MockTreeStorage) ApplyMove(move models.Move) models.PieceStorage {
+	path := storage.path + string(rune('a'+move.Start.File))
+	return MockTreeStorage{path: path}
+}
+
+func (storage MockTreeStorage) hash(seed int) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte{byte(seed)})   // nolint: errcheck
+	hash.Write([]byte(storage.path)) // nolint: errcheck
+	return hash.Sum32()
+}
+
+func TestNewYBWCSearcher(test *testing.T) {
+	var generator MockMoveGenerator
+	var terminator MockSearchTerminator
+	var evaluator MockBoardEvaluator
+	searcher := NewYBWCSearcher(generator, terminator, evaluator, 4)
+
+	if !reflect.DeepEqual(searcher.generator, generator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.terminator, terminator) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(searcher.evaluator, evaluator) {
+		test.Fail()
+	}
+	if cap(searcher.pool) != 3 {
+		test.Fail()
+	}
+}
+
+func TestYBWCSearcherSetSearcher(test *testing.T) {
+	var err interface{}
+	func() {
+		defer func() { err = recover() }()
+
+		var innerSearcher MockMoveSearcher
+		var searcher YBWCSearcher
+		searcher.SetSearcher(innerSearcher)
+	}()
+
+	if err != "not supported" {
+		test.Fail()
+	}
+}
+
+func TestYBWCSearcherSearchMove(test *testing.T) {
+	for seed := 0; seed < 20; seed++ {
+		generator, evaluator := makeMockTree(seed)
+		terminator := terminators.NewDeepTerminator(5)
+
+		wantMove, wantErr := NewAlphaBetaSearcher(generator, terminator, evaluator).
+			SearchMove(MockTreeStorage{}, models.White, 0, moves.NewBounds())
+
+		for _, concurrency := range []int{1, 2, 4, 8} {
+			searcher :=
+				NewYBWCSearcher(generator, terminator, evaluator, concurrency)
+			gotMove, gotErr :=
+				searcher.SearchMove(MockTreeStorage{}, models.White, 0, moves.NewBounds())
+
+			// moves with a same score may be selected in a different order
+			if gotMove.Score != wantMove.Score {
+				test.Fail()
+			}
+			if gotErr != wantErr {
+				test.Fail()
+			}
+		}
+	}
+}
+
+// it makes a synthetic game tree with illegal moves and terminal positions
+func makeMockTree(seed int) (MockMoveGenerator, MockBoardEvaluator) {
+	generator := MockMoveGenerator{
+		movesForColor: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) ([]models.Move, error) {
+			hash := storage.(MockTreeStorage).hash(seed)
+			// some moves are illegal
+			if len(storage.(MockTreeStorage).path) != 0 && hash%7 == 0 {
+				return nil, models.ErrKingCapture
+			}
+
+			// some positions are terminal
+			var moveGroup []models.Move
+			for i := 0; i < int(hash%6); i++ {
+				moveGroup = append(moveGroup, models.Move{
+					Start: models.Position{File: i},
+				})
+			}
+
+			return moveGroup, nil
+		},
+	}
+	evaluator := MockBoardEvaluator{
+		evaluateBoard: func(
+			storage models.PieceStorage,
+			color models.Color,
+		) float64 {
+			hash := storage.(MockTreeStorage).hash(seed + 100)
+			return float64(hash%21) - 10
+		},
+	}
+
+	return generator, evaluator
+}